@@ -0,0 +1,157 @@
+package collections
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// BlockingPriorityQueue is a goroutine-safe Heap whose Push and Pop block
+// until there is room or an element to pop.
+type BlockingPriorityQueue[T any] struct {
+	mu      sync.Mutex
+	changed stateSignal
+	heap    *Heap[T]
+	bound   int
+	closed  bool
+}
+
+// NewBlockingPriorityQueue creates a priority queue holding at most bound elements,
+// a bound of zero means unbounded.
+func NewBlockingPriorityQueue[T any](bound int, compare func(T, T) int) *BlockingPriorityQueue[T] {
+	if bound < 0 {
+		panic("negative blocking priority queue bound")
+	}
+
+	blockingPriorityQueue := BlockingPriorityQueue[T]{
+		heap:  NewHeap(compare),
+		bound: bound,
+	}
+
+	return &blockingPriorityQueue
+}
+
+func (pq *BlockingPriorityQueue[T]) full() bool {
+	return pq.bound > 0 && pq.heap.Len() >= pq.bound
+}
+
+// Push adds value, blocking while the queue is full.
+// It returns ErrClosed if the queue is closed and ctx.Err() if ctx is done first.
+func (pq *BlockingPriorityQueue[T]) Push(ctx context.Context, value T) error {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+
+	for {
+		if pq.closed {
+			return fmt.Errorf("trying to push to closed queue: %w", ErrClosed)
+		}
+
+		if !pq.full() {
+			pq.heap.Push(value)
+			pq.changed.broadcast()
+			return nil
+		}
+
+		if err := pq.changed.wait(ctx, &pq.mu); err != nil {
+			return err
+		}
+	}
+}
+
+// TryPush adds value without blocking and reports false if the queue is full or closed.
+func (pq *BlockingPriorityQueue[T]) TryPush(value T) bool {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+
+	if pq.closed || pq.full() {
+		return false
+	}
+
+	pq.heap.Push(value)
+	pq.changed.broadcast()
+
+	return true
+}
+
+// Pop removes the top element, blocking while the queue is empty.
+// Elements left in a closed queue are still returned, after that
+// Pop returns ErrClosed. It returns ctx.Err() if ctx is done first.
+func (pq *BlockingPriorityQueue[T]) Pop(ctx context.Context) (T, error) {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+
+	for {
+		if value, ok := pq.heap.TryPop(); ok {
+			pq.changed.broadcast()
+			return value, nil
+		}
+
+		if pq.closed {
+			var value T
+			return value, fmt.Errorf("trying to pop from closed queue: %w", ErrClosed)
+		}
+
+		if err := pq.changed.wait(ctx, &pq.mu); err != nil {
+			var value T
+			return value, err
+		}
+	}
+}
+
+// PopUntil is like Pop but also gives up at deadline,
+// returning context.DeadlineExceeded.
+func (pq *BlockingPriorityQueue[T]) PopUntil(ctx context.Context, deadline time.Time) (T, error) {
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+
+	return pq.Pop(ctx)
+}
+
+// TryPop removes the top element without blocking and reports false if the queue is empty.
+func (pq *BlockingPriorityQueue[T]) TryPop() (T, bool) {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+
+	value, ok := pq.heap.TryPop()
+	if ok {
+		pq.changed.broadcast()
+	}
+
+	return value, ok
+}
+
+func (pq *BlockingPriorityQueue[T]) TryPeek() (T, bool) {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+
+	return pq.heap.TryPeek()
+}
+
+// Close stops accepting new elements and wakes up all blocked callers.
+// Closing an already closed queue has no effect.
+func (pq *BlockingPriorityQueue[T]) Close() {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+
+	if pq.closed {
+		return
+	}
+
+	pq.closed = true
+	pq.changed.broadcast()
+}
+
+func (pq *BlockingPriorityQueue[T]) Closed() bool {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+
+	return pq.closed
+}
+
+func (pq *BlockingPriorityQueue[T]) Len() int {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+
+	return pq.heap.Len()
+}
@@ -0,0 +1,181 @@
+package collections
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockingPriorityQueuePushPop(t *testing.T) {
+	queue := NewBlockingPriorityQueue(0, intCompare)
+	ctx := context.Background()
+
+	for _, value := range []int{5, 1, 4, 2, 3} {
+		require.NoError(t, queue.Push(ctx, value))
+	}
+
+	top, ok := queue.TryPeek()
+	assert.True(t, ok)
+	assert.Equal(t, 1, top)
+
+	popped := make([]int, 0)
+	for queue.Len() > 0 {
+		value, err := queue.Pop(ctx)
+		require.NoError(t, err)
+		popped = append(popped, value)
+	}
+
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, popped)
+
+	_, ok = queue.TryPop()
+	assert.False(t, ok)
+}
+
+func TestBlockingPriorityQueueBound(t *testing.T) {
+	queue := NewBlockingPriorityQueue(2, intCompare)
+
+	assert.True(t, queue.TryPush(2))
+	assert.True(t, queue.TryPush(1))
+	assert.False(t, queue.TryPush(0))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, queue.Push(ctx, 0), context.DeadlineExceeded)
+
+	pushed := make(chan error, 1)
+	go func() {
+		pushed <- queue.Push(context.Background(), 0)
+	}()
+
+	value, ok := queue.TryPop()
+
+	assert.True(t, ok)
+	assert.Equal(t, 1, value)
+	assert.NoError(t, <-pushed)
+	assert.Equal(t, 2, queue.Len())
+}
+
+func TestBlockingPriorityQueuePopUntil(t *testing.T) {
+	queue := NewBlockingPriorityQueue(0, intCompare)
+
+	type result struct {
+		value int
+		err   error
+	}
+
+	popped := make(chan result, 1)
+	go func() {
+		value, err := queue.PopUntil(context.Background(), time.Now().Add(10*time.Second))
+		popped <- result{value, err}
+	}()
+
+	waitParked(t, &queue.mu, &queue.changed)
+	queue.TryPush(7)
+
+	pop := <-popped
+	assert.NoError(t, pop.err)
+	assert.Equal(t, 7, pop.value)
+
+	_, err := queue.PopUntil(context.Background(), time.Now().Add(5*time.Millisecond))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = queue.PopUntil(ctx, time.Now().Add(time.Second))
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestBlockingPriorityQueueClose(t *testing.T) {
+	queue := NewBlockingPriorityQueue(0, intCompare)
+	ctx := context.Background()
+	for _, value := range []int{3, 1, 2} {
+		require.NoError(t, queue.Push(ctx, value))
+	}
+
+	blocked := NewBlockingPriorityQueue(0, intCompare)
+	blockedErr := make(chan error, 1)
+	go func() {
+		_, err := blocked.Pop(ctx)
+		blockedErr <- err
+	}()
+
+	queue.Close()
+	queue.Close()
+	waitParked(t, &blocked.mu, &blocked.changed)
+	blocked.Close()
+
+	popped := make([]int, 0)
+	var popErr error
+	for {
+		value, err := queue.Pop(ctx)
+		if err != nil {
+			popErr = err
+			break
+		}
+		popped = append(popped, value)
+	}
+
+	assert.True(t, queue.Closed())
+	assert.Equal(t, []int{1, 2, 3}, popped)
+	assert.True(t, errors.Is(popErr, ErrClosed))
+	assert.True(t, errors.Is(queue.Push(ctx, 4), ErrClosed))
+	assert.False(t, queue.TryPush(4))
+	assert.True(t, errors.Is(<-blockedErr, ErrClosed))
+}
+
+func TestBlockingPriorityQueueConcurrent(t *testing.T) {
+	const producers = 8
+	const consumers = 8
+	const perProducer = 5000
+
+	queue := NewBlockingPriorityQueue(32, intCompare)
+	ctx := context.Background()
+
+	var producersWg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		producersWg.Add(1)
+		go func() {
+			defer producersWg.Done()
+			for i := 0; i < perProducer; i++ {
+				assert.NoError(t, queue.Push(ctx, p*perProducer+i))
+			}
+		}()
+	}
+
+	results := make(chan []int, consumers)
+	for c := 0; c < consumers; c++ {
+		go func() {
+			popped := make([]int, 0)
+			for {
+				value, err := queue.Pop(ctx)
+				if err != nil {
+					results <- popped
+					return
+				}
+				popped = append(popped, value)
+			}
+		}()
+	}
+
+	producersWg.Wait()
+	queue.Close()
+
+	all := make([]int, 0, producers*perProducer)
+	for c := 0; c < consumers; c++ {
+		all = append(all, <-results...)
+	}
+	slices.Sort(all)
+
+	expected := make([]int, producers*perProducer)
+	for i := range expected {
+		expected[i] = i
+	}
+
+	assert.Equal(t, expected, all)
+}
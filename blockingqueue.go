@@ -0,0 +1,127 @@
+package collections
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// BlockingQueue is a goroutine-safe Queue whose Put and Take block
+// until there is room or an element to take.
+type BlockingQueue[T any] struct {
+	mu      sync.Mutex
+	changed stateSignal
+	queue   Queue[T]
+	closed  bool
+}
+
+// NewBlockingQueue creates a queue holding at most bound elements,
+// a bound of zero means unbounded.
+func NewBlockingQueue[T any](bound int) *BlockingQueue[T] {
+	if bound < 0 {
+		panic("negative blocking queue bound")
+	}
+
+	var blockingQueue BlockingQueue[T]
+	blockingQueue.queue.applyOptions(QueueOptions{
+		MaxCapacity: bound,
+	})
+
+	return &blockingQueue
+}
+
+// Put enqueues value, blocking while the queue is full.
+// It returns ErrClosed if the queue is closed and ctx.Err() if ctx is done first.
+func (bq *BlockingQueue[T]) Put(ctx context.Context, value T) error {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+
+	for {
+		if bq.closed {
+			return fmt.Errorf("trying to put to closed queue: %w", ErrClosed)
+		}
+
+		if bq.queue.TryEnqueue(value) {
+			bq.changed.broadcast()
+			return nil
+		}
+
+		if err := bq.changed.wait(ctx, &bq.mu); err != nil {
+			return err
+		}
+	}
+}
+
+// Take dequeues a value, blocking while the queue is empty.
+// Elements left in a closed queue are still returned, after that
+// Take returns ErrClosed. It returns ctx.Err() if ctx is done first.
+func (bq *BlockingQueue[T]) Take(ctx context.Context) (T, error) {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+
+	for {
+		if value, ok := bq.queue.TryDequeue(); ok {
+			bq.changed.broadcast()
+			return value, nil
+		}
+
+		if bq.closed {
+			var value T
+			return value, fmt.Errorf("trying to take from closed queue: %w", ErrClosed)
+		}
+
+		if err := bq.changed.wait(ctx, &bq.mu); err != nil {
+			var value T
+			return value, err
+		}
+	}
+}
+
+// Offer is like Put but gives up after timeout, reporting whether value was enqueued.
+// A non-positive timeout does not block.
+func (bq *BlockingQueue[T]) Offer(value T, timeout time.Duration) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	return bq.Put(ctx, value) == nil
+}
+
+// Poll is like Take but gives up after timeout, reporting whether a value was dequeued.
+// A non-positive timeout does not block.
+func (bq *BlockingQueue[T]) Poll(timeout time.Duration) (T, bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	value, err := bq.Take(ctx)
+
+	return value, err == nil
+}
+
+// Close stops accepting new elements and wakes up all blocked callers.
+// Closing an already closed queue has no effect.
+func (bq *BlockingQueue[T]) Close() {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+
+	if bq.closed {
+		return
+	}
+
+	bq.closed = true
+	bq.changed.broadcast()
+}
+
+func (bq *BlockingQueue[T]) Closed() bool {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+
+	return bq.closed
+}
+
+func (bq *BlockingQueue[T]) Len() int {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+
+	return bq.queue.Len()
+}
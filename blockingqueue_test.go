@@ -0,0 +1,194 @@
+package collections
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockingQueuePutTake(t *testing.T) {
+	queue := NewBlockingQueue[int](0)
+	ctx := context.Background()
+
+	for i := 0; i < 5; i++ {
+		require.NoError(t, queue.Put(ctx, i))
+	}
+
+	taken := make([]int, 0)
+	for i := 0; i < 5; i++ {
+		value, err := queue.Take(ctx)
+		require.NoError(t, err)
+		taken = append(taken, value)
+	}
+
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, taken)
+	assert.Equal(t, 0, queue.Len())
+}
+
+func TestBlockingQueueCancellation(t *testing.T) {
+	t.Run("take from empty queue", func(t *testing.T) {
+		queue := NewBlockingQueue[int](0)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		_, err := queue.Take(ctx)
+
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("put to full queue", func(t *testing.T) {
+		queue := NewBlockingQueue[int](1)
+		require.NoError(t, queue.Put(context.Background(), 1))
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := queue.Put(ctx, 2)
+
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, 1, queue.Len())
+	})
+}
+
+func TestBlockingQueueOfferPoll(t *testing.T) {
+	queue := NewBlockingQueue[int](2)
+
+	assert.True(t, queue.Offer(1, 0))
+	assert.True(t, queue.Offer(2, 0))
+	assert.False(t, queue.Offer(3, 5*time.Millisecond))
+
+	value, ok := queue.Poll(0)
+	assert.True(t, ok)
+	assert.Equal(t, 1, value)
+	assert.True(t, queue.Offer(3, 0))
+
+	offered := make(chan bool, 1)
+	go func() {
+		offered <- queue.Offer(4, 10*time.Second)
+	}()
+
+	waitParked(t, &queue.mu, &queue.changed)
+	value, ok = queue.Poll(0)
+	assert.True(t, ok)
+	assert.Equal(t, 2, value)
+	assert.True(t, <-offered)
+
+	for _, expected := range []int{3, 4} {
+		value, ok = queue.Poll(0)
+		assert.True(t, ok)
+		assert.Equal(t, expected, value)
+	}
+
+	_, ok = queue.Poll(5 * time.Millisecond)
+	assert.False(t, ok)
+}
+
+func TestBlockingQueueClose(t *testing.T) {
+	t.Run("drain after close", func(t *testing.T) {
+		queue := NewBlockingQueue[int](0)
+		ctx := context.Background()
+		for i := 0; i < 3; i++ {
+			require.NoError(t, queue.Put(ctx, i))
+		}
+
+		queue.Close()
+		queue.Close()
+		putErr := queue.Put(ctx, 3)
+
+		taken := make([]int, 0)
+		var takeErr error
+		for {
+			value, err := queue.Take(ctx)
+			if err != nil {
+				takeErr = err
+				break
+			}
+			taken = append(taken, value)
+		}
+
+		assert.True(t, queue.Closed())
+		assert.True(t, errors.Is(putErr, ErrClosed))
+		assert.True(t, errors.Is(takeErr, ErrClosed))
+		assert.Equal(t, []int{0, 1, 2}, taken)
+	})
+
+	t.Run("close wakes blocked callers", func(t *testing.T) {
+		full := NewBlockingQueue[int](1)
+		empty := NewBlockingQueue[int](0)
+		require.NoError(t, full.Put(context.Background(), 1))
+
+		errs := make(chan error, 2)
+		go func() {
+			errs <- full.Put(context.Background(), 2)
+		}()
+		go func() {
+			_, err := empty.Take(context.Background())
+			errs <- err
+		}()
+
+		waitParked(t, &full.mu, &full.changed)
+		waitParked(t, &empty.mu, &empty.changed)
+		full.Close()
+		empty.Close()
+
+		for i := 0; i < 2; i++ {
+			assert.True(t, errors.Is(<-errs, ErrClosed))
+		}
+	})
+}
+
+func TestBlockingQueueConcurrent(t *testing.T) {
+	const producers = 8
+	const consumers = 8
+	const perProducer = 5000
+
+	queue := NewBlockingQueue[int](16)
+	ctx := context.Background()
+
+	var producersWg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		producersWg.Add(1)
+		go func() {
+			defer producersWg.Done()
+			for i := 0; i < perProducer; i++ {
+				assert.NoError(t, queue.Put(ctx, p*perProducer+i))
+			}
+		}()
+	}
+
+	results := make(chan []int, consumers)
+	for c := 0; c < consumers; c++ {
+		go func() {
+			taken := make([]int, 0)
+			for {
+				value, err := queue.Take(ctx)
+				if err != nil {
+					results <- taken
+					return
+				}
+				taken = append(taken, value)
+			}
+		}()
+	}
+
+	producersWg.Wait()
+	queue.Close()
+
+	all := make([]int, 0, producers*perProducer)
+	for c := 0; c < consumers; c++ {
+		all = append(all, <-results...)
+	}
+	slices.Sort(all)
+
+	expected := make([]int, producers*perProducer)
+	for i := range expected {
+		expected[i] = i
+	}
+
+	assert.Equal(t, expected, all)
+}
@@ -0,0 +1,34 @@
+package collections
+
+import "cmp"
+
+// Reverse returns a comparator ordering elements opposite to compare.
+func Reverse[T any](compare func(T, T) int) func(T, T) int {
+	return func(a, b T) int {
+		return compare(b, a)
+	}
+}
+
+// By returns a comparator ordering elements by the ascending natural order of key.
+func By[T any, K cmp.Ordered](key func(T) K) func(T, T) int {
+	return func(a, b T) int {
+		return cmp.Compare(key(a), key(b))
+	}
+}
+
+// ThenBy returns a comparator that orders elements by compare and
+// breaks ties by the ascending natural order of key.
+func ThenBy[T any, K cmp.Ordered](compare func(T, T) int, key func(T) K) func(T, T) int {
+	return Then(compare, By(key))
+}
+
+// Then returns a comparator that orders elements by compare and breaks ties by next.
+func Then[T any](compare, next func(T, T) int) func(T, T) int {
+	return func(a, b T) int {
+		if c := compare(a, b); c != 0 {
+			return c
+		}
+
+		return next(a, b)
+	}
+}
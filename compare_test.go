@@ -0,0 +1,107 @@
+package collections
+
+import (
+	"math"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderedHeaps(t *testing.T) {
+	input := []float64{3.5, -1, 7, math.Inf(-1), 0, 7}
+
+	minHeap := NewMinHeap[float64]()
+	maxHeap := NewMaxHeap[float64]()
+	for _, value := range input {
+		minHeap.Push(value)
+		maxHeap.Push(value)
+	}
+
+	ascending := slices.Sorted(slices.Values(input))
+	descending := slices.Clone(ascending)
+	slices.Reverse(descending)
+
+	assert.Equal(t, ascending, slices.Collect(minHeap.Drain()))
+	assert.Equal(t, descending, slices.Collect(maxHeap.Drain()))
+}
+
+func TestOrderedHeapsMerge(t *testing.T) {
+	left := NewMaxHeap[int]()
+	right := left.Ordering().NewHeap()
+	left.Push(1)
+	right.Push(2)
+
+	left.Merge(right)
+
+	assert.Equal(t, []int{2, 1}, slices.Collect(left.Drain()))
+}
+
+func TestComparators(t *testing.T) {
+	type person struct {
+		Name string
+		Age  int
+	}
+
+	people := []person{
+		{Name: "Carol", Age: 30},
+		{Name: "alice", Age: 25},
+		{Name: "Bob", Age: 30},
+		{Name: "Dave", Age: 25},
+	}
+
+	type TestCase struct {
+		Name     string
+		Compare  func(person, person) int
+		Expected []string
+	}
+
+	testCases := []TestCase{
+		{
+			Name:     "by",
+			Compare:  By(func(p person) string { return p.Name }),
+			Expected: []string{"Bob", "Carol", "Dave", "alice"},
+		},
+		{
+			Name: "then by",
+			Compare: ThenBy(
+				By(func(p person) int { return p.Age }),
+				func(p person) string { return strings.ToLower(p.Name) },
+			),
+			Expected: []string{"alice", "Dave", "Bob", "Carol"},
+		},
+		{
+			Name: "reverse then by",
+			Compare: ThenBy(
+				Reverse(By(func(p person) int { return p.Age })),
+				func(p person) string { return p.Name },
+			),
+			Expected: []string{"Bob", "Carol", "Dave", "alice"},
+		},
+		{
+			Name: "then",
+			Compare: Then(
+				By(func(p person) int { return p.Age }),
+				Reverse(By(func(p person) string { return p.Name })),
+			),
+			Expected: []string{"alice", "Dave", "Carol", "Bob"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			heap := NewHeap(testCase.Compare)
+			for _, p := range people {
+				heap.Push(p)
+			}
+
+			actual := make([]string, 0, len(people))
+			for p := range heap.Drain() {
+				actual = append(actual, p.Name)
+			}
+
+			assert.Equal(t, testCase.Expected, actual)
+		})
+	}
+}
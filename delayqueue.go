@@ -0,0 +1,197 @@
+package collections
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Clock abstracts time for DelayQueue so that tests can control it.
+type Clock interface {
+	Now() time.Time
+	NewTimer(d time.Duration) Timer
+}
+
+// Timer sends the current time on C once it fires, unless it was stopped before.
+type Timer interface {
+	C() <-chan time.Time
+	Stop() bool
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) NewTimer(d time.Duration) Timer {
+	return systemTimer{timer: time.NewTimer(d)}
+}
+
+type systemTimer struct {
+	timer *time.Timer
+}
+
+func (t systemTimer) C() <-chan time.Time {
+	return t.timer.C
+}
+
+func (t systemTimer) Stop() bool {
+	return t.timer.Stop()
+}
+
+type delayEntry[T any] struct {
+	value T
+	at    time.Time
+	seq   uint64
+}
+
+// DelayHandle identifies an element scheduled in a DelayQueue.
+type DelayHandle[T any] struct {
+	item *HeapItem[delayEntry[T]]
+}
+
+// DelayQueue is a goroutine-safe queue releasing every element
+// at its scheduled time. Elements due at the same time are released
+// in the order they were scheduled.
+type DelayQueue[T any] struct {
+	mu      sync.Mutex
+	changed stateSignal
+	heap    *IndexedHeap[delayEntry[T]]
+	clock   Clock
+	seq     uint64
+}
+
+func NewDelayQueue[T any]() *DelayQueue[T] {
+	return NewDelayQueueWithClock[T](systemClock{})
+}
+
+func NewDelayQueueWithClock[T any](clock Clock) *DelayQueue[T] {
+	if clock == nil {
+		panic("nil delay queue clock")
+	}
+
+	delayQueue := DelayQueue[T]{
+		heap: NewIndexedHeap(func(a, b delayEntry[T]) int {
+			if c := a.at.Compare(b.at); c != 0 {
+				return c
+			}
+
+			if a.seq < b.seq {
+				return -1
+			}
+
+			if a.seq > b.seq {
+				return 1
+			}
+
+			return 0
+		}),
+		clock: clock,
+	}
+
+	return &delayQueue
+}
+
+// Schedule adds value to be released at the given time
+// and returns a handle that can be used to cancel it.
+func (dq *DelayQueue[T]) Schedule(value T, at time.Time) *DelayHandle[T] {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+
+	item := dq.heap.PushHandle(delayEntry[T]{
+		value: value,
+		at:    at,
+		seq:   dq.seq,
+	})
+	dq.seq++
+
+	// the new element may be due earlier than the one Take is waiting for
+	dq.changed.broadcast()
+
+	return &DelayHandle[T]{item: item}
+}
+
+// Cancel removes the element behind handle and reports whether
+// it was still waiting in the queue.
+func (dq *DelayQueue[T]) Cancel(handle *DelayHandle[T]) bool {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+
+	if handle == nil || !dq.heap.Contains(handle.item) {
+		return false
+	}
+
+	dq.heap.Remove(handle.item)
+	dq.changed.broadcast()
+
+	return true
+}
+
+// Take removes and returns the earliest element, blocking until it is due.
+// It returns ctx.Err() if ctx is done first.
+func (dq *DelayQueue[T]) Take(ctx context.Context) (T, error) {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+
+	for {
+		var timer Timer
+		var fired <-chan time.Time
+
+		if entry, ok := dq.heap.TryPeek(); ok {
+			delay := entry.at.Sub(dq.clock.Now())
+			if delay <= 0 {
+				dq.heap.Pop()
+				return entry.value, nil
+			}
+
+			timer = dq.clock.NewTimer(delay)
+			fired = timer.C()
+		}
+
+		err := dq.changed.waitUntil(ctx, &dq.mu, fired)
+
+		// a change of state may have made the timer obsolete, the next round starts a new one
+		if timer != nil {
+			timer.Stop()
+		}
+
+		if err != nil {
+			var value T
+			return value, err
+		}
+	}
+}
+
+// TryTake removes and returns the earliest element if it is already due.
+func (dq *DelayQueue[T]) TryTake() (T, bool) {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+
+	entry, ok := dq.heap.TryPeek()
+	if !ok || entry.at.After(dq.clock.Now()) {
+		var value T
+		return value, false
+	}
+
+	dq.heap.Pop()
+
+	return entry.value, true
+}
+
+// NextAt returns the time the earliest element is due, if there is one.
+func (dq *DelayQueue[T]) NextAt() (time.Time, bool) {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+
+	entry, ok := dq.heap.TryPeek()
+
+	return entry.at, ok
+}
+
+func (dq *DelayQueue[T]) Len() int {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+
+	return dq.heap.Len()
+}
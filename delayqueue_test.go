@@ -0,0 +1,228 @@
+package collections
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeTimer struct {
+	clock *fakeClock
+	at    time.Time
+	ch    chan time.Time
+}
+
+func (t *fakeTimer) C() <-chan time.Time {
+	return t.ch
+}
+
+func (t *fakeTimer) Stop() bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+
+	for i, timer := range t.clock.timers {
+		if timer == t {
+			t.clock.timers = slices.Delete(t.clock.timers, i, i+1)
+			return true
+		}
+	}
+
+	return false
+}
+
+// fakeClock only moves forward on Advance and keeps the timers that
+// have neither fired nor been stopped.
+type fakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	timers []*fakeTimer
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{
+		now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+func (c *fakeClock) NewTimer(d time.Duration) Timer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	timer := &fakeTimer{
+		clock: c,
+		at:    c.now.Add(d),
+		ch:    make(chan time.Time, 1),
+	}
+	c.timers = append(c.timers, timer)
+
+	return timer
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+
+	pending := c.timers[:0]
+	for _, timer := range c.timers {
+		if timer.at.After(c.now) {
+			pending = append(pending, timer)
+		} else {
+			timer.ch <- c.now
+		}
+	}
+	c.timers = pending
+}
+
+// awaitTimers polls until the timers waiting to fire are due exactly at the given times.
+func (c *fakeClock) awaitTimers(t *testing.T, at ...time.Time) {
+	t.Helper()
+
+	require.Eventually(t, func() bool {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		return slices.EqualFunc(c.timers, at, func(timer *fakeTimer, at time.Time) bool {
+			return timer.at.Equal(at)
+		})
+	}, 10*time.Second, time.Millisecond)
+}
+
+func TestDelayQueueOrder(t *testing.T) {
+	clock := newFakeClock()
+	queue := NewDelayQueueWithClock[string](clock)
+	start := clock.Now()
+
+	queue.Schedule("late", start.Add(3*time.Second))
+	queue.Schedule("early", start.Add(time.Second))
+	queue.Schedule("tie first", start.Add(2*time.Second))
+	queue.Schedule("tie second", start.Add(2*time.Second))
+
+	_, ok := queue.TryTake()
+	assert.False(t, ok)
+
+	next, ok := queue.NextAt()
+	assert.True(t, ok)
+	assert.Equal(t, start.Add(time.Second), next)
+
+	clock.Advance(5 * time.Second)
+
+	taken := make([]string, 0)
+	for queue.Len() > 0 {
+		value, err := queue.Take(context.Background())
+		require.NoError(t, err)
+		taken = append(taken, value)
+	}
+
+	assert.Equal(t, []string{"early", "tie first", "tie second", "late"}, taken)
+}
+
+func TestDelayQueueTakeBlocksUntilDue(t *testing.T) {
+	clock := newFakeClock()
+	queue := NewDelayQueueWithClock[int](clock)
+	due := clock.Now().Add(10 * time.Second)
+	queue.Schedule(1, due)
+
+	taken := make(chan int, 1)
+	go func() {
+		value, err := queue.Take(context.Background())
+		assert.NoError(t, err)
+		taken <- value
+	}()
+
+	clock.awaitTimers(t, due)
+	clock.Advance(9 * time.Second)
+
+	select {
+	case <-taken:
+		t.Fatal("element released before it was due")
+	default:
+	}
+
+	clock.Advance(time.Second)
+
+	assert.Equal(t, 1, <-taken)
+}
+
+func TestDelayQueueScheduleEarlierWakesTake(t *testing.T) {
+	clock := newFakeClock()
+	queue := NewDelayQueueWithClock[int](clock)
+	late := clock.Now().Add(time.Hour)
+	early := clock.Now().Add(time.Second)
+	queue.Schedule(1, late)
+
+	taken := make(chan int, 1)
+	go func() {
+		value, err := queue.Take(context.Background())
+		assert.NoError(t, err)
+		taken <- value
+	}()
+
+	// the timer for the later element is stopped once Take wakes up
+	clock.awaitTimers(t, late)
+	queue.Schedule(2, early)
+	clock.awaitTimers(t, early)
+	clock.Advance(time.Second)
+
+	assert.Equal(t, 2, <-taken)
+	assert.Equal(t, 1, queue.Len())
+}
+
+func TestDelayQueueCancel(t *testing.T) {
+	clock := newFakeClock()
+	queue := NewDelayQueueWithClock[int](clock)
+	other := NewDelayQueueWithClock[int](clock)
+
+	first := queue.Schedule(1, clock.Now().Add(time.Second))
+	second := queue.Schedule(2, clock.Now().Add(2*time.Second))
+	foreign := other.Schedule(3, clock.Now())
+
+	assert.True(t, queue.Cancel(first))
+	assert.False(t, queue.Cancel(first))
+	assert.False(t, queue.Cancel(foreign))
+	assert.False(t, queue.Cancel(nil))
+
+	clock.Advance(2 * time.Second)
+
+	value, ok := queue.TryTake()
+	assert.True(t, ok)
+	assert.Equal(t, 2, value)
+	assert.False(t, queue.Cancel(second))
+	assert.Equal(t, 0, queue.Len())
+}
+
+func TestDelayQueueTakeCancellation(t *testing.T) {
+	queue := NewDelayQueueWithClock[int](newFakeClock())
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := queue.Take(ctx)
+
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestDelayQueueSystemClock(t *testing.T) {
+	queue := NewDelayQueue[int]()
+	queue.Schedule(1, time.Now().Add(5*time.Millisecond))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	value, err := queue.Take(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, value)
+}
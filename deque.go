@@ -0,0 +1,206 @@
+package collections
+
+import (
+	"fmt"
+	"iter"
+)
+
+// Deque is a double-ended queue sharing the ring buffer of Queue.
+// The front of the deque is the read end of the queue.
+type Deque[T any] struct {
+	queue Queue[T]
+}
+
+func NewDeque[T any]() *Deque[T] {
+	return &Deque[T]{}
+}
+
+func NewDequeWithOptions[T any](options QueueOptions) *Deque[T] {
+	var deque Deque[T]
+	deque.queue.applyOptions(options)
+
+	return &deque
+}
+
+func (d *Deque[T]) PushBack(value T) {
+	if !d.TryPushBack(value) {
+		panic(fmt.Errorf("trying to push to deque at max capacity: %w", ErrFull))
+	}
+}
+
+func (d *Deque[T]) TryPushBack(value T) bool {
+	return d.queue.TryEnqueue(value)
+}
+
+func (d *Deque[T]) PushFront(value T) {
+	if !d.TryPushFront(value) {
+		panic(fmt.Errorf("trying to push to deque at max capacity: %w", ErrFull))
+	}
+}
+
+func (d *Deque[T]) TryPushFront(value T) bool {
+	q := &d.queue
+	if !q.makeRoom() {
+		return false
+	}
+
+	q.read = (q.read - 1 + len(q.buf)) % len(q.buf)
+	q.buf[q.read] = value
+
+	q.len++
+
+	return true
+}
+
+func (d *Deque[T]) PopFront() T {
+	value, ok := d.TryPopFront()
+	if !ok {
+		panic(fmt.Errorf("trying to pop from empty deque: %w", ErrEmpty))
+	}
+
+	return value
+}
+
+func (d *Deque[T]) TryPopFront() (T, bool) {
+	return d.queue.TryDequeue()
+}
+
+func (d *Deque[T]) PopBack() T {
+	value, ok := d.TryPopBack()
+	if !ok {
+		panic(fmt.Errorf("trying to pop from empty deque: %w", ErrEmpty))
+	}
+
+	return value
+}
+
+func (d *Deque[T]) TryPopBack() (T, bool) {
+	var value T
+
+	q := &d.queue
+	if q.len == 0 {
+		return value, false
+	}
+
+	var zero T
+
+	q.write = (q.write - 1 + len(q.buf)) % len(q.buf)
+	value = q.buf[q.write]
+	q.buf[q.write] = zero
+
+	q.len--
+	q.shrinkIfSparse()
+
+	return value, true
+}
+
+func (d *Deque[T]) PeekFront() T {
+	value, ok := d.TryPeekFront()
+	if !ok {
+		panic(fmt.Errorf("trying to peek from empty deque: %w", ErrEmpty))
+	}
+
+	return value
+}
+
+func (d *Deque[T]) TryPeekFront() (T, bool) {
+	return d.queue.TryPeek()
+}
+
+func (d *Deque[T]) PeekBack() T {
+	value, ok := d.TryPeekBack()
+	if !ok {
+		panic(fmt.Errorf("trying to peek from empty deque: %w", ErrEmpty))
+	}
+
+	return value
+}
+
+func (d *Deque[T]) TryPeekBack() (T, bool) {
+	return d.TryAt(d.queue.len - 1)
+}
+
+func (d *Deque[T]) At(index int) T {
+	value, ok := d.TryAt(index)
+	if !ok {
+		panic(indexOutOfRange(index, d.queue.len))
+	}
+
+	return value
+}
+
+func (d *Deque[T]) TryAt(index int) (T, bool) {
+	var value T
+
+	q := &d.queue
+	if index < 0 || index >= q.len {
+		return value, false
+	}
+
+	return q.buf[(q.read+index)%len(q.buf)], true
+}
+
+func (d *Deque[T]) Set(index int, value T) {
+	if !d.TrySet(index, value) {
+		panic(indexOutOfRange(index, d.queue.len))
+	}
+}
+
+func (d *Deque[T]) TrySet(index int, value T) bool {
+	q := &d.queue
+	if index < 0 || index >= q.len {
+		return false
+	}
+
+	q.buf[(q.read+index)%len(q.buf)] = value
+
+	return true
+}
+
+func (d *Deque[T]) Len() int {
+	return d.queue.Len()
+}
+
+func (d *Deque[T]) Cap() int {
+	return d.queue.Cap()
+}
+
+func (d *Deque[T]) Grow(targetCapacity int) {
+	d.queue.Grow(targetCapacity)
+}
+
+func (d *Deque[T]) Shrink() {
+	d.queue.Shrink()
+}
+
+func (d *Deque[T]) TrimToSize() {
+	d.queue.TrimToSize()
+}
+
+// All yields the elements from front to back, with the same
+// mutation guarantees as Queue.All.
+func (d *Deque[T]) All() iter.Seq[T] {
+	return d.queue.All()
+}
+
+// Indexed is like All but also yields the position of each element.
+func (d *Deque[T]) Indexed() iter.Seq2[int, T] {
+	return d.queue.Indexed()
+}
+
+// Backward yields the elements from back to front.
+// The deque is read live, so popping from the back during iteration is safe.
+func (d *Deque[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := d.queue.len - 1; i >= 0; i-- {
+			value, ok := d.TryAt(i)
+			if !ok {
+				continue
+			}
+
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,224 @@
+package collections
+
+import (
+	"errors"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDequePush(t *testing.T) {
+	type TestCase struct {
+		Name     string
+		Front    []int
+		Back     []int
+		Expected []int
+	}
+
+	testCases := []TestCase{
+		{
+			Name:     "empty deque",
+			Front:    []int{},
+			Back:     []int{},
+			Expected: []int{},
+		},
+		{
+			Name:     "front only",
+			Front:    []int{1, 2, 3},
+			Back:     []int{},
+			Expected: []int{3, 2, 1},
+		},
+		{
+			Name:     "back only",
+			Front:    []int{},
+			Back:     []int{1, 2, 3},
+			Expected: []int{1, 2, 3},
+		},
+		{
+			Name:     "both ends",
+			Front:    []int{3, 2, 1},
+			Back:     []int{4, 5, 6, 7},
+			Expected: []int{1, 2, 3, 4, 5, 6, 7},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			deque := NewDeque[int]()
+
+			for i := 0; i < max(len(testCase.Front), len(testCase.Back)); i++ {
+				if i < len(testCase.Front) {
+					deque.PushFront(testCase.Front[i])
+				}
+				if i < len(testCase.Back) {
+					deque.PushBack(testCase.Back[i])
+				}
+			}
+
+			actual := make([]int, 0)
+			for i := 0; i < deque.Len(); i++ {
+				actual = append(actual, deque.At(i))
+			}
+
+			assert.Equal(t, testCase.Expected, actual)
+		})
+	}
+}
+
+func TestDequePop(t *testing.T) {
+	deque := NewDeque[int]()
+	for i := 1; i <= 5; i++ {
+		deque.PushBack(i)
+	}
+
+	assert.Equal(t, 1, deque.PeekFront())
+	assert.Equal(t, 5, deque.PeekBack())
+	assert.Equal(t, 1, deque.PopFront())
+	assert.Equal(t, 5, deque.PopBack())
+	assert.Equal(t, 4, deque.PopBack())
+	assert.Equal(t, 2, deque.PopFront())
+	assert.Equal(t, 3, deque.PopBack())
+
+	_, okFront := deque.TryPopFront()
+	_, okBack := deque.TryPopBack()
+	_, okPeekFront := deque.TryPeekFront()
+	_, okPeekBack := deque.TryPeekBack()
+
+	assert.False(t, okFront)
+	assert.False(t, okBack)
+	assert.False(t, okPeekFront)
+	assert.False(t, okPeekBack)
+}
+
+func TestDequeAgainstSlice(t *testing.T) {
+	rnd := rand.New(rand.NewSource(42))
+	deque := NewDeque[int]()
+	reference := make([]int, 0)
+
+	for i := 0; i < 100000; i++ {
+		switch rnd.Intn(5) {
+		case 0:
+			deque.PushFront(i)
+			reference = slices.Insert(reference, 0, i)
+		case 1:
+			deque.PushBack(i)
+			reference = append(reference, i)
+		case 2:
+			value, ok := deque.TryPopFront()
+			require.Equal(t, len(reference) > 0, ok)
+			if ok {
+				require.Equal(t, reference[0], value)
+				reference = reference[1:]
+			}
+		case 3:
+			value, ok := deque.TryPopBack()
+			require.Equal(t, len(reference) > 0, ok)
+			if ok {
+				require.Equal(t, reference[len(reference)-1], value)
+				reference = reference[:len(reference)-1]
+			}
+		case 4:
+			if len(reference) > 0 {
+				index := rnd.Intn(len(reference))
+				deque.Set(index, -i)
+				reference[index] = -i
+			}
+		}
+
+		require.Equal(t, len(reference), deque.Len())
+	}
+
+	for i, value := range deque.Indexed() {
+		assert.Equal(t, reference[i], value)
+	}
+}
+
+func TestDequeBackward(t *testing.T) {
+	deque := NewDeque[int]()
+	for i := 1; i <= 4; i++ {
+		deque.PushFront(i)
+	}
+
+	assert.Equal(t, []int{1, 2, 3, 4}, slices.Collect(deque.Backward()))
+
+	visited := make([]int, 0)
+	for value := range deque.Backward() {
+		visited = append(visited, value)
+		deque.PopBack()
+	}
+
+	assert.Equal(t, []int{1, 2, 3, 4}, visited)
+	assert.Equal(t, 0, deque.Len())
+}
+
+func TestDequePanicErrors(t *testing.T) {
+	type TestCase struct {
+		Name   string
+		Call   func(deque *Deque[int])
+		Target error
+	}
+
+	testCases := []TestCase{
+		{
+			Name:   "pop front",
+			Call:   func(deque *Deque[int]) { deque.PopFront() },
+			Target: ErrEmpty,
+		},
+		{
+			Name:   "pop back",
+			Call:   func(deque *Deque[int]) { deque.PopBack() },
+			Target: ErrEmpty,
+		},
+		{
+			Name:   "peek back",
+			Call:   func(deque *Deque[int]) { deque.PeekBack() },
+			Target: ErrEmpty,
+		},
+		{
+			Name:   "at",
+			Call:   func(deque *Deque[int]) { deque.At(0) },
+			Target: ErrIndexOutOfRange,
+		},
+		{
+			Name:   "set",
+			Call:   func(deque *Deque[int]) { deque.Set(-1, 0) },
+			Target: ErrIndexOutOfRange,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			defer func() {
+				err, ok := recover().(error)
+				require.True(t, ok)
+				assert.True(t, errors.Is(err, testCase.Target))
+			}()
+
+			testCase.Call(NewDeque[int]())
+		})
+	}
+}
+
+func TestDequeOptions(t *testing.T) {
+	deque := NewDequeWithOptions[int](QueueOptions{MaxCapacity: 3, ShrinkThreshold: 0.25})
+
+	require.True(t, deque.TryPushFront(2))
+	require.True(t, deque.TryPushBack(3))
+	require.True(t, deque.TryPushFront(1))
+	okFront := deque.TryPushFront(0)
+	okBack := deque.TryPushBack(4)
+
+	assert.False(t, okFront)
+	assert.False(t, okBack)
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(deque.All()))
+
+	deque.PopBack()
+	deque.PopBack()
+	deque.TrimToSize()
+
+	assert.Equal(t, 1, deque.Cap())
+	assert.Equal(t, 1, deque.PeekBack())
+}
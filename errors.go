@@ -0,0 +1,21 @@
+package collections
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrEmpty           = errors.New("collection is empty")
+	ErrIndexOutOfRange = errors.New("index out of range")
+	ErrFull            = errors.New("collection is full")
+	ErrClosed          = errors.New("collection is closed")
+
+	ErrIncompatibleComparators = errors.New("incompatible comparators")
+	ErrForeignNode             = errors.New("node does not belong to list")
+	ErrCorruptedList           = errors.New("corrupted list")
+)
+
+func indexOutOfRange(index, length int) error {
+	return fmt.Errorf("index %d with length %d: %w", index, length, ErrIndexOutOfRange)
+}
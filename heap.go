@@ -1,92 +1,317 @@
 package collections
 
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"slices"
+)
+
 type Heap[T any] struct {
-	slice   []T
-	compare func(T, T) int
+	slice    []T
+	ordering *Ordering[T]
+	compare  func(T, T) int
+	arity    int
+
+	// onMove, when set, is called whenever an element lands at a new index
+	onMove func(value T, index int)
 }
 
 func NewHeap[T any](compare func(T, T) int) *Heap[T] {
-	heap := Heap[T]{
-		slice:   make([]T, 0),
-		compare: compare,
+	return NewDaryHeap(2, compare)
+}
+
+// NewMinHeap creates a heap popping the smallest element first.
+func NewMinHeap[T cmp.Ordered]() *Heap[T] {
+	return NewHeap(cmp.Compare[T])
+}
+
+// NewMaxHeap creates a heap popping the largest element first.
+func NewMaxHeap[T cmp.Ordered]() *Heap[T] {
+	return NewHeap(Reverse(cmp.Compare[T]))
+}
+
+// NewDaryHeap creates a heap in which every node has up to d children.
+// A larger d makes the heap shallower, so Push does fewer moves
+// while Pop does more comparisons per level.
+func NewDaryHeap[T any](d int, compare func(T, T) int) *Heap[T] {
+	return NewOrdering(compare).NewDaryHeap(d)
+}
+
+// NewHeapFromSlice builds a heap from a copy of items in O(n).
+func NewHeapFromSlice[T any](items []T, compare func(T, T) int) *Heap[T] {
+	return NewDaryHeapFromSlice(2, items, compare)
+}
+
+// NewHeapFromSliceInPlace builds a heap in O(n) that takes ownership of items
+// and reorders it in place. The caller must not use items afterwards,
+// except to modify elements in place followed by a call to Rebuild.
+func NewHeapFromSliceInPlace[T any](items []T, compare func(T, T) int) *Heap[T] {
+	return NewDaryHeapFromSliceInPlace(2, items, compare)
+}
+
+// NewDaryHeapFromSlice is like NewHeapFromSlice for a heap with up to d children per node.
+func NewDaryHeapFromSlice[T any](d int, items []T, compare func(T, T) int) *Heap[T] {
+	return NewDaryHeapFromSliceInPlace(d, slices.Clone(items), compare)
+}
+
+// NewDaryHeapFromSliceInPlace is like NewHeapFromSliceInPlace for a heap with up to d children per node.
+func NewDaryHeapFromSliceInPlace[T any](d int, items []T, compare func(T, T) int) *Heap[T] {
+	heap := NewDaryHeap(d, compare)
+	if items != nil {
+		heap.slice = items
 	}
+	heap.Rebuild()
 
-	return &heap
+	return heap
+}
+
+// Rebuild restores the heap order in O(n) after elements were changed in place.
+func (h *Heap[T]) Rebuild() {
+	if len(h.slice) < 2 {
+		return
+	}
+
+	for i := h.parent(len(h.slice) - 1); i >= 0; i-- {
+		h.heapifyDown(i)
+	}
 }
 
 func (h *Heap[T]) Push(value T) {
 	h.slice = append(h.slice, value)
+	h.moved(len(h.slice) - 1)
 	h.heapifyUp(len(h.slice) - 1)
 }
 
 func (h *Heap[T]) Pop() T {
+	value, ok := h.TryPop()
+	if !ok {
+		panic(fmt.Errorf("pop from empty heap: %w", ErrEmpty))
+	}
+
+	return value
+}
+
+func (h *Heap[T]) TryPop() (T, bool) {
+	var value T
+
 	if len(h.slice) == 0 {
-		panic("pop from empty heap")
+		return value, false
 	}
 
-	value := h.slice[0]
-	h.slice[0] = h.slice[len(h.slice)-1]
-	h.slice = h.slice[:len(h.slice)-1]
+	return h.removeAt(0), true
+}
 
-	if len(h.slice) > 0 {
-		h.heapifyDown(0)
+// PushPop pushes value and pops the top element with a single sift,
+// returning value straight away if it would be the top itself.
+func (h *Heap[T]) PushPop(value T) T {
+	if len(h.slice) == 0 || h.compare(value, h.slice[0]) <= 0 {
+		return value
 	}
 
-	return value
+	return h.replaceTop(value)
+}
+
+// Replace pops the top element and pushes value with a single sift.
+// Unlike PushPop the returned element may be worse than value.
+func (h *Heap[T]) Replace(value T) T {
+	top, ok := h.TryReplace(value)
+	if !ok {
+		panic(fmt.Errorf("replace in empty heap: %w", ErrEmpty))
+	}
+
+	return top
+}
+
+// TryReplace is like Replace but leaves an empty heap unchanged and reports false.
+func (h *Heap[T]) TryReplace(value T) (T, bool) {
+	if len(h.slice) == 0 {
+		var top T
+		return top, false
+	}
+
+	return h.replaceTop(value), true
+}
+
+func (h *Heap[T]) replaceTop(value T) T {
+	top := h.slice[0]
+	h.slice[0] = value
+	h.moved(0)
+	h.heapifyDown(0)
+
+	return top
 }
 
 func (h *Heap[T]) Peek() T {
+	value, ok := h.TryPeek()
+	if !ok {
+		panic(fmt.Errorf("peek from empty heap: %w", ErrEmpty))
+	}
+
+	return value
+}
+
+func (h *Heap[T]) TryPeek() (T, bool) {
+	var value T
+
 	if len(h.slice) == 0 {
-		panic("peek from empty heap")
+		return value, false
 	}
 
-	return h.slice[0]
+	return h.slice[0], true
 }
 
 func (h *Heap[T]) Len() int {
 	return len(h.slice)
 }
 
+// Ordering returns the Ordering of h, from which heaps that can be merged with h are created.
+func (h *Heap[T]) Ordering() *Ordering[T] {
+	return h.ordering
+}
+
+// Merge moves all elements of other into h in O(n+m), leaving other empty.
+// Both heaps must share an Ordering, for example by creating other
+// from h.Ordering(), otherwise Merge panics with ErrIncompatibleComparators.
+func (h *Heap[T]) Merge(other *Heap[T]) {
+	if h == other {
+		panic("trying to merge heap with itself")
+	}
+
+	mustShareOrdering(h.ordering, other.ordering)
+
+	start := len(h.slice)
+	h.slice = append(h.slice, other.slice...)
+	for i := start; i < len(h.slice); i++ {
+		h.moved(i)
+	}
+	h.Rebuild()
+
+	clear(other.slice)
+	other.slice = other.slice[:0]
+}
+
+// MergeHeaps returns a new heap holding the elements of all heaps in O(n),
+// leaving them unchanged. All heaps must share an Ordering,
+// and the result has the arity of the first heap.
+func MergeHeaps[T any](heaps ...*Heap[T]) *Heap[T] {
+	if len(heaps) == 0 {
+		panic("trying to merge no heaps")
+	}
+
+	total := 0
+	for _, heap := range heaps {
+		mustShareOrdering(heaps[0].ordering, heap.ordering)
+		total += heap.Len()
+	}
+
+	items := make([]T, 0, total)
+	for _, heap := range heaps {
+		items = append(items, heap.slice...)
+	}
+
+	heap := heaps[0].ordering.NewDaryHeap(heaps[0].arity)
+	heap.slice = items
+	heap.Rebuild()
+
+	return heap
+}
+
+func (h *Heap[T]) removeAt(index int) T {
+	var zero T
+
+	last := len(h.slice) - 1
+	value := h.slice[index]
+	h.slice[index] = h.slice[last]
+	h.slice[last] = zero
+	h.slice = h.slice[:last]
+
+	if index < last {
+		h.moved(index)
+		h.fix(index)
+	}
+
+	return value
+}
+
+// fix restores the heap order after the element at index changed.
+func (h *Heap[T]) fix(index int) {
+	h.heapifyDown(index)
+	h.heapifyUp(index)
+}
+
+func (h *Heap[T]) swap(i, j int) {
+	h.slice[i], h.slice[j] = h.slice[j], h.slice[i]
+	h.moved(i)
+	h.moved(j)
+}
+
+func (h *Heap[T]) moved(index int) {
+	if h.onMove != nil {
+		h.onMove(h.slice[index], index)
+	}
+}
+
 func (h *Heap[T]) heapifyUp(index int) {
-	for i, p := index, parent(index); p >= 0 && p < i; i, p = p, parent(p) {
+	for i, p := index, h.parent(index); p >= 0 && p < i; i, p = p, h.parent(p) {
 		cmp := h.compare(h.slice[i], h.slice[p])
 		if cmp < 0 {
-			h.slice[i], h.slice[p] = h.slice[p], h.slice[i]
+			h.swap(i, p)
 		}
 	}
 }
 
 func (h *Heap[T]) heapifyDown(index int) {
 	for {
-		left := leftChildren(index)
-		right := rightChildren(index)
+		first := h.firstChild(index)
 		smallest := index
 
-		if left < len(h.slice) && h.compare(h.slice[left], h.slice[smallest]) < 0 {
-			smallest = left
-		}
-
-		if right < len(h.slice) && h.compare(h.slice[right], h.slice[smallest]) < 0 {
-			smallest = right
+		for child := first; child < min(first+h.arity, len(h.slice)); child++ {
+			if h.compare(h.slice[child], h.slice[smallest]) < 0 {
+				smallest = child
+			}
 		}
 
 		if smallest == index {
 			break
 		}
 
-		h.slice[smallest], h.slice[index] = h.slice[index], h.slice[smallest]
+		h.swap(smallest, index)
 		index = smallest
 	}
 }
 
-func parent(index int) int {
-	return (index - 1) / 2
+func (h *Heap[T]) parent(index int) int {
+	return (index - 1) / h.arity
+}
+
+func (h *Heap[T]) firstChild(index int) int {
+	return h.arity*index + 1
 }
 
-func leftChildren(index int) int {
-	return 2*index + 1
+// All yields the elements in unspecified order without removing them.
+// The heap is read live, so modifying it during iteration
+// may cause elements to be skipped or yielded twice.
+func (h *Heap[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < len(h.slice); i++ {
+			if !yield(h.slice[i]) {
+				return
+			}
+		}
+	}
 }
 
-func rightChildren(index int) int {
-	return 2*index + 2
+// Drain pops and yields elements in priority order until the heap is empty.
+// Elements pushed during iteration are drained as well.
+// Breaking out of the loop leaves the remaining elements in the heap.
+func (h *Heap[T]) Drain() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for {
+			value, ok := h.TryPop()
+			if !ok || !yield(value) {
+				return
+			}
+		}
+	}
 }
@@ -1,13 +1,28 @@
 package collections
 
 import (
+	"errors"
+	"fmt"
 	"math/rand"
 	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
+func intCompare(a, b int) int {
+	if a < b {
+		return -1
+	}
+
+	if a > b {
+		return 1
+	}
+
+	return 0
+}
+
 func createNewMinIntHeap() *Heap[int] {
 	cmp := func(a, b int) int {
 		if a < b {
@@ -165,3 +180,525 @@ func TestMaxIntHeap(t *testing.T) {
 		})
 	}
 }
+
+func TestHeapTryPop(t *testing.T) {
+	t.Run("empty heap", func(t *testing.T) {
+		heap := createNewMinIntHeap()
+
+		value, ok := heap.TryPop()
+
+		assert.False(t, ok)
+		assert.Equal(t, 0, value)
+	})
+
+	t.Run("pops in order", func(t *testing.T) {
+		heap := createNewMinIntHeap()
+		for _, num := range []int{3, 1, 2} {
+			heap.Push(num)
+		}
+
+		var popped []int
+		for {
+			value, ok := heap.TryPop()
+			if !ok {
+				break
+			}
+
+			popped = append(popped, value)
+		}
+
+		assert.Equal(t, []int{1, 2, 3}, popped)
+	})
+}
+
+func TestHeapTryPeek(t *testing.T) {
+	t.Run("empty heap", func(t *testing.T) {
+		heap := createNewMinIntHeap()
+
+		_, ok := heap.TryPeek()
+
+		assert.False(t, ok)
+	})
+
+	t.Run("non-empty heap", func(t *testing.T) {
+		heap := createNewMinIntHeap()
+		heap.Push(2)
+		heap.Push(1)
+
+		value, ok := heap.TryPeek()
+
+		assert.True(t, ok)
+		assert.Equal(t, 1, value)
+		assert.Equal(t, 2, heap.Len())
+	})
+}
+
+func TestHeapPanicErrors(t *testing.T) {
+	testCases := map[string]func(heap *Heap[int]){
+		"pop":  func(heap *Heap[int]) { heap.Pop() },
+		"peek": func(heap *Heap[int]) { heap.Peek() },
+	}
+
+	for name, call := range testCases {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				err, ok := recover().(error)
+				require.True(t, ok)
+				assert.True(t, errors.Is(err, ErrEmpty))
+			}()
+
+			call(createNewMinIntHeap())
+		})
+	}
+}
+
+func TestHeapIterators(t *testing.T) {
+	input := []int{5, 3, 8, 1, 9, 2}
+	sorted := slices.Clone(input)
+	slices.Sort(sorted)
+
+	t.Run("all", func(t *testing.T) {
+		heap := createNewMinIntHeap()
+		for _, num := range input {
+			heap.Push(num)
+		}
+
+		actual := slices.Sorted(heap.All())
+
+		assert.Equal(t, sorted, actual)
+		assert.Equal(t, len(input), heap.Len())
+	})
+
+	t.Run("drain", func(t *testing.T) {
+		heap := createNewMinIntHeap()
+		for _, num := range input {
+			heap.Push(num)
+		}
+
+		actual := slices.Collect(heap.Drain())
+
+		assert.Equal(t, sorted, actual)
+		assert.Equal(t, 0, heap.Len())
+	})
+
+	t.Run("push during drain", func(t *testing.T) {
+		heap := createNewMinIntHeap()
+		heap.Push(10)
+		heap.Push(20)
+
+		actual := make([]int, 0)
+		for value := range heap.Drain() {
+			actual = append(actual, value)
+			if value == 10 {
+				heap.Push(15)
+			}
+		}
+
+		assert.Equal(t, []int{10, 15, 20}, actual)
+	})
+
+	t.Run("break during drain", func(t *testing.T) {
+		heap := createNewMinIntHeap()
+		for _, num := range input {
+			heap.Push(num)
+		}
+
+		for range heap.Drain() {
+			break
+		}
+
+		assert.Equal(t, len(input)-1, heap.Len())
+		assert.Equal(t, sorted[1], heap.Peek())
+	})
+}
+
+func TestNewHeapFromSlice(t *testing.T) {
+	type TestCase struct {
+		Name  string
+		Input []int
+	}
+
+	var largeSlice []int
+	for i := 0; i < 10000; i++ {
+		largeSlice = append(largeSlice, rand.Intn(10000))
+	}
+
+	testCases := []TestCase{
+		{
+			Name:  "nil slice",
+			Input: nil,
+		},
+		{
+			Name:  "single element",
+			Input: []int{1},
+		},
+		{
+			Name:  "three elements",
+			Input: []int{2, 1, 3},
+		},
+		{
+			Name:  "large slice",
+			Input: largeSlice,
+		},
+	}
+
+	cmp := func(a, b int) int {
+		return a - b
+	}
+
+	for _, testCase := range testCases {
+		expected := append([]int{}, testCase.Input...)
+		slices.Sort(expected)
+
+		t.Run(fmt.Sprintf("copy %s", testCase.Name), func(t *testing.T) {
+			input := slices.Clone(testCase.Input)
+
+			heap := NewHeapFromSlice(input, cmp)
+			heap.Push(-1)
+			actual := slices.Collect(heap.Drain())
+
+			assert.Equal(t, append([]int{-1}, expected...), actual)
+			assert.Equal(t, testCase.Input, input)
+		})
+
+		t.Run(fmt.Sprintf("in place %s", testCase.Name), func(t *testing.T) {
+			heap := NewHeapFromSliceInPlace(slices.Clone(testCase.Input), cmp)
+			actual := make([]int, 0)
+			for value := range heap.Drain() {
+				actual = append(actual, value)
+			}
+
+			assert.Equal(t, expected, actual)
+		})
+	}
+}
+
+func TestHeapRebuild(t *testing.T) {
+	items := []int{5, 3, 8, 1, 9, 2}
+	heap := NewHeapFromSliceInPlace(items, func(a, b int) int { return a - b })
+
+	for i := range items {
+		items[i] = -items[i]
+	}
+	heap.Rebuild()
+
+	assert.Equal(t, []int{-9, -8, -5, -3, -2, -1}, slices.Collect(heap.Drain()))
+}
+
+func TestHeapMerge(t *testing.T) {
+	type TestCase struct {
+		Name  string
+		Left  []int
+		Right []int
+	}
+
+	testCases := []TestCase{
+		{
+			Name:  "both empty",
+			Left:  []int{},
+			Right: []int{},
+		},
+		{
+			Name:  "empty other",
+			Left:  []int{3, 1, 2},
+			Right: []int{},
+		},
+		{
+			Name:  "empty receiver",
+			Left:  []int{},
+			Right: []int{3, 1, 2},
+		},
+		{
+			Name:  "interleaved",
+			Left:  []int{9, 1, 7, 3, 5},
+			Right: []int{2, 8, 4, 6, 0},
+		},
+	}
+
+	for _, testCase := range testCases {
+		expected := append(slices.Clone(testCase.Left), testCase.Right...)
+		slices.Sort(expected)
+
+		t.Run(fmt.Sprintf("merge %s", testCase.Name), func(t *testing.T) {
+			left := createNewMinIntHeap()
+			right := left.Ordering().NewHeap()
+			for _, num := range testCase.Left {
+				left.Push(num)
+			}
+			for _, num := range testCase.Right {
+				right.Push(num)
+			}
+
+			left.Merge(right)
+			actual := make([]int, 0)
+			for value := range left.Drain() {
+				actual = append(actual, value)
+			}
+
+			assert.Equal(t, expected, actual)
+			assert.Equal(t, 0, right.Len())
+		})
+
+		t.Run(fmt.Sprintf("merge heaps %s", testCase.Name), func(t *testing.T) {
+			ordering := NewOrdering(intCompare)
+			left := ordering.NewHeap()
+			right := ordering.NewDaryHeap(4)
+			for _, num := range testCase.Left {
+				left.Push(num)
+			}
+			for _, num := range testCase.Right {
+				right.Push(num)
+			}
+
+			merged := MergeHeaps(left, right)
+			actual := make([]int, 0)
+			for value := range merged.Drain() {
+				actual = append(actual, value)
+			}
+
+			assert.Equal(t, expected, actual)
+			assert.Equal(t, len(testCase.Left), left.Len())
+			assert.Equal(t, len(testCase.Right), right.Len())
+		})
+	}
+
+	t.Run("different orderings", func(t *testing.T) {
+		pairs := map[string][2]*Heap[int]{
+			"different comparators": {createNewMinIntHeap(), createNewMaxIntHeap()},
+			"same comparator":       {NewHeap(intCompare), NewHeap(intCompare)},
+		}
+
+		for name, pair := range pairs {
+			require.PanicsWithError(t, "trying to merge heaps: "+ErrIncompatibleComparators.Error(), func() {
+				pair[0].Merge(pair[1])
+			}, name)
+			require.PanicsWithError(t, "trying to merge heaps: "+ErrIncompatibleComparators.Error(), func() {
+				MergeHeaps(pair[0], pair[1])
+			}, name)
+		}
+	})
+
+	t.Run("merge with itself", func(t *testing.T) {
+		require.Panics(t, func() {
+			heap := createNewMinIntHeap()
+			heap.Merge(heap)
+		})
+	})
+
+	t.Run("merge no heaps", func(t *testing.T) {
+		require.Panics(t, func() {
+			MergeHeaps[int]()
+		})
+	})
+}
+
+func TestDaryHeap(t *testing.T) {
+	var largeSlice []int
+	for i := 0; i < 10000; i++ {
+		largeSlice = append(largeSlice, rand.Intn(10000))
+	}
+	sortedLargeSlice := slices.Sorted(slices.Values(largeSlice))
+
+	for _, d := range []int{2, 3, 4, 8} {
+		t.Run(fmt.Sprintf("push d=%d", d), func(t *testing.T) {
+			heap := NewDaryHeap(d, intCompare)
+			for _, num := range largeSlice {
+				heap.Push(num)
+			}
+
+			assert.Equal(t, sortedLargeSlice, slices.Collect(heap.Drain()))
+		})
+
+		t.Run(fmt.Sprintf("from slice d=%d", d), func(t *testing.T) {
+			input := slices.Clone(largeSlice)
+			heap := NewDaryHeapFromSlice(d, input, intCompare)
+			inPlace := NewDaryHeapFromSliceInPlace(d, slices.Clone(largeSlice), intCompare)
+
+			assert.Equal(t, largeSlice, input)
+			assert.Equal(t, d, heap.arity)
+			assert.Equal(t, d, inPlace.arity)
+			assert.Equal(t, sortedLargeSlice, slices.Collect(heap.Drain()))
+			assert.Equal(t, sortedLargeSlice, slices.Collect(inPlace.Drain()))
+		})
+
+		t.Run(fmt.Sprintf("rebuild and merge d=%d", d), func(t *testing.T) {
+			heap := NewDaryHeap(d, intCompare)
+			other := heap.Ordering().NewDaryHeap(d)
+			for i, num := range largeSlice {
+				if i%2 == 0 {
+					heap.Push(num)
+				} else {
+					other.Push(num)
+				}
+			}
+
+			merged := MergeHeaps(heap, other)
+			heap.Merge(other)
+
+			assert.Equal(t, sortedLargeSlice, slices.Collect(merged.Drain()))
+			assert.Equal(t, sortedLargeSlice, slices.Collect(heap.Drain()))
+		})
+	}
+
+	t.Run("invalid arity", func(t *testing.T) {
+		require.Panics(t, func() {
+			NewDaryHeap(1, intCompare)
+		})
+		require.Panics(t, func() {
+			NewDaryHeapFromSlice(1, []int{1, 2}, intCompare)
+		})
+	})
+}
+
+func BenchmarkDaryHeap(b *testing.B) {
+	const size = 1 << 16
+
+	values := make([]int, size)
+	for i := range values {
+		values[i] = rand.Int()
+	}
+
+	for _, d := range []int{2, 4, 8} {
+		b.Run(fmt.Sprintf("push-heavy d=%d", d), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				heap := NewDaryHeap(d, intCompare)
+				for _, value := range values {
+					heap.Push(value)
+				}
+			}
+		})
+
+		b.Run(fmt.Sprintf("pop-heavy d=%d", d), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				heap := NewDaryHeap(d, intCompare)
+				for _, value := range values {
+					heap.Push(value)
+				}
+				b.StartTimer()
+
+				for heap.Len() > 0 {
+					heap.Pop()
+				}
+			}
+		})
+	}
+}
+
+func TestHeapPushPop(t *testing.T) {
+	type TestCase struct {
+		Name         string
+		Heap         []int
+		Value        int
+		Expected     int
+		ExpectedHeap []int
+	}
+
+	testCases := []TestCase{
+		{
+			Name:         "empty heap",
+			Heap:         []int{},
+			Value:        5,
+			Expected:     5,
+			ExpectedHeap: []int{},
+		},
+		{
+			Name:         "value beats root",
+			Heap:         []int{3, 7, 5},
+			Value:        1,
+			Expected:     1,
+			ExpectedHeap: []int{3, 5, 7},
+		},
+		{
+			Name:         "value equals root",
+			Heap:         []int{3, 7, 5},
+			Value:        3,
+			Expected:     3,
+			ExpectedHeap: []int{3, 5, 7},
+		},
+		{
+			Name:         "root beats value",
+			Heap:         []int{3, 7, 5},
+			Value:        6,
+			Expected:     3,
+			ExpectedHeap: []int{5, 6, 7},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			heap := NewHeapFromSlice(testCase.Heap, intCompare)
+
+			actual := heap.PushPop(testCase.Value)
+			contents := make([]int, 0)
+			for value := range heap.Drain() {
+				contents = append(contents, value)
+			}
+
+			assert.Equal(t, testCase.Expected, actual)
+			assert.Equal(t, testCase.ExpectedHeap, contents)
+		})
+	}
+}
+
+func TestHeapReplace(t *testing.T) {
+	type TestCase struct {
+		Name         string
+		Heap         []int
+		Value        int
+		Expected     int
+		ExpectedHeap []int
+	}
+
+	testCases := []TestCase{
+		{
+			Name:         "value beats root",
+			Heap:         []int{3, 7, 5},
+			Value:        1,
+			Expected:     3,
+			ExpectedHeap: []int{1, 5, 7},
+		},
+		{
+			Name:         "root beats value",
+			Heap:         []int{3, 7, 5},
+			Value:        9,
+			Expected:     3,
+			ExpectedHeap: []int{5, 7, 9},
+		},
+		{
+			Name:         "single element",
+			Heap:         []int{3},
+			Value:        4,
+			Expected:     3,
+			ExpectedHeap: []int{4},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			heap := NewHeapFromSlice(testCase.Heap, intCompare)
+
+			actual := heap.Replace(testCase.Value)
+
+			assert.Equal(t, testCase.Expected, actual)
+			assert.Equal(t, testCase.ExpectedHeap, slices.Collect(heap.Drain()))
+		})
+	}
+
+	t.Run("empty heap", func(t *testing.T) {
+		heap := createNewMinIntHeap()
+
+		_, ok := heap.TryReplace(1)
+
+		assert.False(t, ok)
+		assert.Equal(t, 0, heap.Len())
+
+		defer func() {
+			err, ok := recover().(error)
+			require.True(t, ok)
+			assert.True(t, errors.Is(err, ErrEmpty))
+		}()
+
+		heap.Replace(1)
+	})
+}
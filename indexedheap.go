@@ -0,0 +1,172 @@
+package collections
+
+import (
+	"fmt"
+	"iter"
+)
+
+// HeapItem is a handle to an element of an IndexedHeap.
+// After changing Value in place, call IndexedHeap.Fix to restore the heap order.
+type HeapItem[T any] struct {
+	Value T
+	index int
+}
+
+// IndexedHeap is a heap whose elements can be updated or removed
+// through the handles returned by PushHandle in O(log n).
+type IndexedHeap[T any] struct {
+	heap    *Heap[*HeapItem[T]]
+	compare func(T, T) int
+}
+
+func NewIndexedHeap[T any](compare func(T, T) int) *IndexedHeap[T] {
+	heap := NewHeap(func(a, b *HeapItem[T]) int {
+		return compare(a.Value, b.Value)
+	})
+	heap.onMove = func(item *HeapItem[T], index int) {
+		item.index = index
+	}
+
+	indexedHeap := IndexedHeap[T]{
+		heap:    heap,
+		compare: compare,
+	}
+
+	return &indexedHeap
+}
+
+func (h *IndexedHeap[T]) PushHandle(value T) *HeapItem[T] {
+	item := &HeapItem[T]{
+		Value: value,
+	}
+	h.heap.Push(item)
+
+	return item
+}
+
+func (h *IndexedHeap[T]) Pop() T {
+	value, ok := h.TryPop()
+	if !ok {
+		panic(fmt.Errorf("pop from empty heap: %w", ErrEmpty))
+	}
+
+	return value
+}
+
+func (h *IndexedHeap[T]) TryPop() (T, bool) {
+	item, ok := h.heap.TryPop()
+	if !ok {
+		var value T
+		return value, false
+	}
+
+	item.index = -1
+
+	return item.Value, true
+}
+
+func (h *IndexedHeap[T]) Peek() T {
+	value, ok := h.TryPeek()
+	if !ok {
+		panic(fmt.Errorf("peek from empty heap: %w", ErrEmpty))
+	}
+
+	return value
+}
+
+func (h *IndexedHeap[T]) TryPeek() (T, bool) {
+	item, ok := h.heap.TryPeek()
+	if !ok {
+		var value T
+		return value, false
+	}
+
+	return item.Value, true
+}
+
+// PeekHandle returns the handle of the top element, or nil if the heap is empty.
+func (h *IndexedHeap[T]) PeekHandle() *HeapItem[T] {
+	item, _ := h.heap.TryPeek()
+
+	return item
+}
+
+func (h *IndexedHeap[T]) Len() int {
+	return h.heap.Len()
+}
+
+// Contains reports whether handle belongs to an element currently in the heap.
+func (h *IndexedHeap[T]) Contains(handle *HeapItem[T]) bool {
+	return handle != nil &&
+		handle.index >= 0 &&
+		handle.index < len(h.heap.slice) &&
+		h.heap.slice[handle.index] == handle
+}
+
+func (h *IndexedHeap[T]) mustContain(handle *HeapItem[T]) {
+	if !h.Contains(handle) {
+		panic("handle does not belong to heap")
+	}
+}
+
+// Fix restores the heap order after handle.Value was changed in place.
+func (h *IndexedHeap[T]) Fix(handle *HeapItem[T]) {
+	h.mustContain(handle)
+
+	h.heap.fix(handle.index)
+}
+
+// Update replaces the value behind handle and restores the heap order.
+func (h *IndexedHeap[T]) Update(handle *HeapItem[T], value T) {
+	h.mustContain(handle)
+
+	handle.Value = value
+	h.heap.fix(handle.index)
+}
+
+// DecreaseKey moves the element behind handle towards the top by replacing its value.
+// It panics if value would move the element away from the top.
+func (h *IndexedHeap[T]) DecreaseKey(handle *HeapItem[T], value T) {
+	h.mustContain(handle)
+
+	if h.compare(value, handle.Value) > 0 {
+		panic("decrease key with a lower priority value")
+	}
+
+	handle.Value = value
+	h.heap.heapifyUp(handle.index)
+}
+
+// Remove deletes the element behind handle from the heap and returns its value.
+func (h *IndexedHeap[T]) Remove(handle *HeapItem[T]) T {
+	h.mustContain(handle)
+
+	item := h.heap.removeAt(handle.index)
+	item.index = -1
+
+	return item.Value
+}
+
+// All yields the elements in unspecified order, with the same
+// mutation guarantees as Heap.All.
+func (h *IndexedHeap[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for item := range h.heap.All() {
+			if !yield(item.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Drain pops and yields elements in priority order until the heap is empty.
+func (h *IndexedHeap[T]) Drain() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for {
+			value, ok := h.TryPop()
+			if !ok || !yield(value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package collections
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndexedHeapHandles(t *testing.T) {
+	heap := NewIndexedHeap(intCompare)
+	handles := make(map[int]*HeapItem[int])
+	for _, value := range []int{50, 30, 70, 10, 60} {
+		handles[value] = heap.PushHandle(value)
+	}
+
+	heap.DecreaseKey(handles[70], 5)
+	assert.Equal(t, 5, heap.Peek())
+	assert.Same(t, handles[70], heap.PeekHandle())
+
+	heap.Update(handles[10], 100)
+	handles[50].Value = 1
+	heap.Fix(handles[50])
+
+	removed := heap.Remove(handles[30])
+
+	assert.Equal(t, 30, removed)
+	assert.False(t, heap.Contains(handles[30]))
+	assert.True(t, heap.Contains(handles[60]))
+	assert.Equal(t, []int{1, 5, 60, 100}, slices.Collect(heap.Drain()))
+	assert.False(t, heap.Contains(handles[60]))
+	assert.Nil(t, heap.PeekHandle())
+}
+
+func TestIndexedHeapInvalidHandles(t *testing.T) {
+	heap := NewIndexedHeap(intCompare)
+	other := NewIndexedHeap(intCompare)
+	handle := heap.PushHandle(10)
+	foreign := other.PushHandle(10)
+
+	assert.False(t, heap.Contains(nil))
+	assert.False(t, heap.Contains(foreign))
+
+	require.Panics(t, func() {
+		heap.Remove(foreign)
+	})
+
+	require.Panics(t, func() {
+		heap.DecreaseKey(handle, 20)
+	})
+
+	heap.Pop()
+
+	require.Panics(t, func() {
+		heap.Fix(handle)
+	})
+}
+
+func TestIndexedHeapAgainstReference(t *testing.T) {
+	rnd := rand.New(rand.NewSource(7))
+	heap := NewIndexedHeap(intCompare)
+	handles := make([]*HeapItem[int], 0)
+
+	for i := 0; i < 20000; i++ {
+		switch op := rnd.Intn(6); {
+		case op < 2 || len(handles) == 0:
+			handles = append(handles, heap.PushHandle(rnd.Intn(1000)))
+		case op == 2:
+			handle := handles[rnd.Intn(len(handles))]
+			heap.Update(handle, rnd.Intn(1000))
+		case op == 3:
+			handle := handles[rnd.Intn(len(handles))]
+			heap.DecreaseKey(handle, handle.Value-rnd.Intn(100))
+		case op == 4:
+			index := rnd.Intn(len(handles))
+			heap.Remove(handles[index])
+			handles = slices.Delete(handles, index, index+1)
+		case op == 5:
+			expected := slices.MinFunc(handles, func(a, b *HeapItem[int]) int {
+				return a.Value - b.Value
+			}).Value
+			value := heap.Pop()
+			require.Equal(t, expected, value)
+
+			index := slices.IndexFunc(handles, func(handle *HeapItem[int]) bool {
+				return !heap.Contains(handle)
+			})
+			require.Equal(t, value, handles[index].Value)
+			handles = slices.Delete(handles, index, index+1)
+		}
+
+		require.Equal(t, len(handles), heap.Len())
+	}
+
+	reference := make([]int, 0, len(handles))
+	for _, handle := range handles {
+		require.True(t, heap.Contains(handle))
+		reference = append(reference, handle.Value)
+	}
+	slices.Sort(reference)
+
+	assert.Equal(t, reference, slices.Collect(heap.Drain()))
+}
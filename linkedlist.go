@@ -1,15 +1,59 @@
 package collections
 
+import (
+	"fmt"
+	"iter"
+)
+
 type LinkedList[T any] struct {
 	Head  *LinkedListNode[T]
 	Tail  *LinkedListNode[T]
 	count int
+
+	// owner is shared by all nodes of the list and created by the first push
+	owner *listOwner[T]
 }
 
 type LinkedListNode[T any] struct {
 	Value T
 	Next  *LinkedListNode[T]
 	Prev  *LinkedListNode[T]
+
+	owner *listOwner[T]
+}
+
+// listOwner identifies the list its nodes belong to. When a list steals all nodes
+// of another, the other owner forwards to the stealing one instead of every node being updated.
+type listOwner[T any] struct {
+	list    *LinkedList[T]
+	forward *listOwner[T]
+}
+
+// resolve follows forwarding to the owner of a live list, compressing the path on the way.
+func (o *listOwner[T]) resolve() *listOwner[T] {
+	root := o
+	for root.forward != nil {
+		root = root.forward
+	}
+
+	for o != root {
+		next := o.forward
+		o.forward = root
+		o = next
+	}
+
+	return root
+}
+
+// List returns the list the node belongs to, or nil once it was removed.
+func (node *LinkedListNode[T]) List() *LinkedList[T] {
+	if node.owner == nil {
+		return nil
+	}
+
+	node.owner = node.owner.resolve()
+
+	return node.owner.list
 }
 
 func NewLinkedList[T any]() *LinkedList[T] {
@@ -17,132 +61,685 @@ func NewLinkedList[T any]() *LinkedList[T] {
 	return &linkedList
 }
 
-func (ll *LinkedList[T]) PushFront(value T) {
+func (ll *LinkedList[T]) PushFront(value T) *LinkedListNode[T] {
 	var node LinkedListNode[T]
 	node.Value = value
 
-	if ll.Head == nil {
-		ll.Tail = &node
-	} else {
-		node.Next = ll.Head
-		ll.Head.Prev = &node
-	}
+	ll.link(&node, nil, ll.Head)
 
-	ll.Head = &node
-	ll.count++
+	return &node
 }
 
-func (ll *LinkedList[T]) PushBack(value T) {
+func (ll *LinkedList[T]) PushBack(value T) *LinkedListNode[T] {
 	var node LinkedListNode[T]
 	node.Value = value
 
-	if ll.Tail == nil {
-		ll.Head = &node
-	} else {
-		node.Prev = ll.Tail
-		ll.Tail.Next = &node
-	}
+	ll.link(&node, ll.Tail, nil)
 
-	ll.Tail = &node
-	ll.count++
+	return &node
 }
 
 func (ll *LinkedList[T]) PopFront() T {
+	value, ok := ll.TryPopFront()
+	if !ok {
+		panic(fmt.Errorf("cannot remove from empty list: %w", ErrEmpty))
+	}
+
+	return value
+}
+
+func (ll *LinkedList[T]) TryPopFront() (T, bool) {
 	var value T
 
 	if ll.Head == nil {
-		panic("cannot remove from empty list")
+		return value, false
 	}
 
-	value = ll.Head.Value
+	node := ll.Head
+	ll.unlink(node)
 
-	if ll.Head == ll.Tail {
-		ll.Head, ll.Tail = nil, nil
-	} else {
-		ll.Head.Next.Prev = nil
-		ll.Head = ll.Head.Next
-	}
+	return node.Value, true
+}
 
-	ll.count--
+func (ll *LinkedList[T]) PopBack() T {
+	value, ok := ll.TryPopBack()
+	if !ok {
+		panic(fmt.Errorf("cannot remove from empty list: %w", ErrEmpty))
+	}
 
 	return value
 }
 
-func (ll *LinkedList[T]) PopBack() T {
+func (ll *LinkedList[T]) TryPopBack() (T, bool) {
 	var value T
 
 	if ll.Head == nil {
-		panic("cannot remove from empty list")
+		return value, false
+	}
+
+	node := ll.Tail
+	ll.unlink(node)
+
+	return node.Value, true
+}
+
+// Insert inserts value so that it ends up at index, which may equal Len()
+// to append, and returns the new node.
+func (ll *LinkedList[T]) Insert(index int, value T) *LinkedListNode[T] {
+	node, ok := ll.TryInsert(index, value)
+	if !ok {
+		panic(indexOutOfRange(index, ll.count))
+	}
+
+	return node
+}
+
+func (ll *LinkedList[T]) TryInsert(index int, value T) (*LinkedListNode[T], bool) {
+	if index < 0 || index > ll.count {
+		return nil, false
+	}
+
+	if index == ll.count {
+		return ll.PushBack(value), true
+	}
+
+	return ll.InsertBefore(ll.locate(index), value), true
+}
+
+// RemoveRange removes the elements with indexes in [from, to).
+func (ll *LinkedList[T]) RemoveRange(from, to int) {
+	if from < 0 || to > ll.count || from > to {
+		panic(fmt.Errorf("range [%d, %d) with length %d: %w", from, to, ll.count, ErrIndexOutOfRange))
+	}
+
+	if from == to {
+		return
+	}
+
+	node := ll.locate(from)
+	for i := from; i < to; i++ {
+		next := node.Next
+		ll.unlink(node)
+		node = next
 	}
+}
+
+// InsertBefore inserts value right before mark in O(1) and returns the new node.
+func (ll *LinkedList[T]) InsertBefore(mark *LinkedListNode[T], value T) *LinkedListNode[T] {
+	ll.mustOwn(mark)
+
+	var node LinkedListNode[T]
+	node.Value = value
+
+	ll.link(&node, mark.Prev, mark)
 
-	value = ll.Tail.Value
+	return &node
+}
+
+// InsertAfter inserts value right after mark in O(1) and returns the new node.
+func (ll *LinkedList[T]) InsertAfter(mark *LinkedListNode[T], value T) *LinkedListNode[T] {
+	ll.mustOwn(mark)
+
+	var node LinkedListNode[T]
+	node.Value = value
+
+	ll.link(&node, mark, mark.Next)
+
+	return &node
+}
 
-	if ll.Head == ll.Tail {
-		ll.Head, ll.Tail = nil, nil
+// RemoveNode unlinks node from the list in O(1) and returns its value.
+func (ll *LinkedList[T]) RemoveNode(node *LinkedListNode[T]) T {
+	ll.mustOwn(node)
+
+	ll.unlink(node)
+
+	return node.Value
+}
+
+func (ll *LinkedList[T]) MoveToFront(node *LinkedListNode[T]) {
+	ll.mustOwn(node)
+
+	if ll.Head == node {
+		return
+	}
+
+	ll.unlink(node)
+	ll.link(node, nil, ll.Head)
+}
+
+func (ll *LinkedList[T]) MoveToBack(node *LinkedListNode[T]) {
+	ll.mustOwn(node)
+
+	if ll.Tail == node {
+		return
+	}
+
+	ll.unlink(node)
+	ll.link(node, ll.Tail, nil)
+}
+
+// MoveBefore moves node right before mark. Moving a node relative to itself has no effect.
+func (ll *LinkedList[T]) MoveBefore(node, mark *LinkedListNode[T]) {
+	ll.mustOwn(node)
+	ll.mustOwn(mark)
+
+	if node == mark || node.Next == mark {
+		return
+	}
+
+	ll.unlink(node)
+	ll.link(node, mark.Prev, mark)
+}
+
+// MoveAfter moves node right after mark. Moving a node relative to itself has no effect.
+func (ll *LinkedList[T]) MoveAfter(node, mark *LinkedListNode[T]) {
+	ll.mustOwn(node)
+	ll.mustOwn(mark)
+
+	if node == mark || node.Prev == mark {
+		return
+	}
+
+	ll.unlink(node)
+	ll.link(node, mark, mark.Next)
+}
+
+// PushBackList moves all nodes of other to the back of the list in O(1), leaving other empty.
+// The moved nodes keep their identity and belong to the list afterwards.
+func (ll *LinkedList[T]) PushBackList(other *LinkedList[T]) {
+	ll.splice(other, ll.Tail, nil)
+}
+
+// PushFrontList moves all nodes of other to the front of the list in O(1), leaving other empty.
+func (ll *LinkedList[T]) PushFrontList(other *LinkedList[T]) {
+	ll.splice(other, nil, ll.Head)
+}
+
+// SpliceAfter moves all nodes of other right after mark in O(1), leaving other empty.
+func (ll *LinkedList[T]) SpliceAfter(mark *LinkedListNode[T], other *LinkedList[T]) {
+	ll.mustOwn(mark)
+
+	ll.splice(other, mark, mark.Next)
+}
+
+// SplitAt moves the nodes from index to the end into a new list and returns it.
+// Index may equal Len() to return an empty list. It runs in O(Len()),
+// as the moved nodes have to be counted and handed over to the new list.
+func (ll *LinkedList[T]) SplitAt(index int) *LinkedList[T] {
+	if index < 0 || index > ll.count {
+		panic(indexOutOfRange(index, ll.count))
+	}
+
+	if index == ll.count {
+		return NewLinkedList[T]()
+	}
+
+	if index == 0 {
+		rest := NewLinkedList[T]()
+		rest.PushBackList(ll)
+
+		return rest
+	}
+
+	return ll.splitFrom(ll.locate(index))
+}
+
+// SplitAfter moves the nodes following mark into a new list and returns it.
+func (ll *LinkedList[T]) SplitAfter(mark *LinkedListNode[T]) *LinkedList[T] {
+	ll.mustOwn(mark)
+
+	if mark.Next == nil {
+		return NewLinkedList[T]()
+	}
+
+	return ll.splitFrom(mark.Next)
+}
+
+// splice links all nodes of other between prev and next and empties other.
+func (ll *LinkedList[T]) splice(other *LinkedList[T], prev, next *LinkedListNode[T]) {
+	if other == ll {
+		panic("trying to splice list into itself")
+	}
+
+	if other.Head == nil {
+		return
+	}
+
+	other.Head.Prev, other.Tail.Next = prev, next
+
+	if prev == nil {
+		ll.Head = other.Head
 	} else {
-		ll.Tail.Prev.Next = nil
-		ll.Tail = ll.Tail.Prev
+		prev.Next = other.Head
 	}
 
-	ll.count--
+	if next == nil {
+		ll.Tail = other.Tail
+	} else {
+		next.Prev = other.Tail
+	}
 
-	return value
+	ll.adopt(other)
 }
 
-func (ll *LinkedList[T]) Len() int {
-	return ll.count
+// adopt takes over the nodes of other, which are already linked into the list, and empties other.
+func (ll *LinkedList[T]) adopt(other *LinkedList[T]) {
+	ll.count += other.count
+
+	// the stolen nodes still point to the owner of other, so forward it instead of visiting them
+	other.owner.list = nil
+	other.owner.forward = ll.ownerToken()
+
+	other.Head, other.Tail = nil, nil
+	other.count = 0
+	other.owner = nil
 }
 
-func (ll *LinkedList[T]) Get(index int) T {
-	if index < 0 || index >= ll.count {
-		panic("index out of range")
+// splitFrom moves first and all nodes after it into a new list.
+func (ll *LinkedList[T]) splitFrom(first *LinkedListNode[T]) *LinkedList[T] {
+	rest := NewLinkedList[T]()
+	owner := rest.ownerToken()
+
+	for node := first; node != nil; node = node.Next {
+		node.owner = owner
+		rest.count++
 	}
 
-	var node = ll.Head
-	for i := 0; i < index; i++ {
-		node = node.Next
+	rest.Head, rest.Tail = first, ll.Tail
+
+	ll.Tail = first.Prev
+	ll.Tail.Next = nil
+	first.Prev = nil
+	ll.count -= rest.count
+
+	return rest
+}
+
+// Sort sorts the list in place with a stable bottom-up merge sort in O(n log n).
+// Nodes are relinked rather than copied, so they keep their values and stay in the list.
+func (ll *LinkedList[T]) Sort(compare func(T, T) int) {
+	if ll.count < 2 {
+		return
 	}
 
-	return node.Value
+	// runs are merged through Next only, Prev is restored once at the end
+	head := ll.Head
+	for width := 1; width < ll.count; width *= 2 {
+		var last *LinkedListNode[T]
+
+		rest := head
+		for rest != nil {
+			left := rest
+			right := cutAfter(left, width)
+			rest = cutAfter(right, width)
+
+			first, end := mergeChains(left, right, compare)
+			if last == nil {
+				head = first
+			} else {
+				last.Next = first
+			}
+			last = end
+		}
+	}
+
+	ll.relinkBackward(head)
 }
 
-func (ll *LinkedList[T]) Set(index int, value T) {
-	if index < 0 || index >= ll.count {
-		panic("index out of range")
+// IsSorted reports whether no element is less than the one before it.
+func (ll *LinkedList[T]) IsSorted(compare func(T, T) int) bool {
+	for node := ll.Head; node != nil && node.Next != nil; node = node.Next {
+		if compare(node.Next.Value, node.Value) < 0 {
+			return false
+		}
 	}
 
-	var node = ll.Head
-	for i := 0; i < index; i++ {
+	return true
+}
+
+// InsertSorted inserts value into a sorted list after all elements not greater than it
+// and returns the new node. The search starts at the tail, so inserting in order is O(1).
+func (ll *LinkedList[T]) InsertSorted(value T, compare func(T, T) int) *LinkedListNode[T] {
+	for mark := ll.Tail; mark != nil; mark = mark.Prev {
+		if compare(mark.Value, value) <= 0 {
+			return ll.InsertAfter(mark, value)
+		}
+	}
+
+	return ll.PushFront(value)
+}
+
+// MergeSorted moves all nodes of the sorted list other into the sorted list in O(n+m),
+// leaving other empty. Equal elements of the list stay before those of other.
+func (ll *LinkedList[T]) MergeSorted(other *LinkedList[T], compare func(T, T) int) {
+	if other == ll {
+		panic("trying to merge list with itself")
+	}
+
+	if other.Head == nil {
+		return
+	}
+
+	head, _ := mergeChains(ll.Head, other.Head, compare)
+	ll.relinkBackward(head)
+	ll.adopt(other)
+}
+
+// cutAfter terminates the chain starting at node after n nodes and returns the remainder.
+func cutAfter[T any](node *LinkedListNode[T], n int) *LinkedListNode[T] {
+	for i := 1; node != nil && i < n; i++ {
 		node = node.Next
 	}
 
-	node.Value = value
+	if node == nil {
+		return nil
+	}
+
+	rest := node.Next
+	node.Next = nil
+
+	return rest
 }
 
-func (ll *LinkedList[T]) Remove(index int) T {
-	if index < 0 || index >= ll.count {
-		panic("index out of range")
+// mergeChains merges two sorted nil-terminated chains through Next and returns
+// the ends of the result. Taking from a on ties keeps the merge stable.
+func mergeChains[T any](a, b *LinkedListNode[T], compare func(T, T) int) (first, last *LinkedListNode[T]) {
+	for a != nil && b != nil {
+		var next *LinkedListNode[T]
+		if compare(b.Value, a.Value) < 0 {
+			next, b = b, b.Next
+		} else {
+			next, a = a, a.Next
+		}
+
+		if last == nil {
+			first = next
+		} else {
+			last.Next = next
+		}
+		last = next
+	}
+
+	rest := a
+	if rest == nil {
+		rest = b
+	}
+
+	if last == nil {
+		first, last = rest, rest
+	} else {
+		last.Next = rest
+	}
+
+	for last != nil && last.Next != nil {
+		last = last.Next
+	}
+
+	return first, last
+}
+
+// relinkBackward makes head the first node and restores Prev and Tail along Next.
+func (ll *LinkedList[T]) relinkBackward(head *LinkedListNode[T]) {
+	var prev *LinkedListNode[T]
+	for node := head; node != nil; node = node.Next {
+		node.Prev = prev
+		prev = node
 	}
 
-	var node = ll.Head
+	ll.Head, ll.Tail = head, prev
+}
+
+func (ll *LinkedList[T]) ownerToken() *listOwner[T] {
+	if ll.owner == nil {
+		ll.owner = &listOwner[T]{list: ll}
+	}
+
+	return ll.owner
+}
+
+// locate returns the node at a valid index, walking from whichever end is nearer.
+func (ll *LinkedList[T]) locate(index int) *LinkedListNode[T] {
+	if index > ll.count/2 {
+		node := ll.Tail
+		for i := ll.count - 1; i > index; i-- {
+			node = node.Prev
+		}
+
+		return node
+	}
+
+	node := ll.Head
 	for i := 0; i < index; i++ {
 		node = node.Next
 	}
 
-	if node == ll.Head && node == ll.Tail {
-		ll.Head, ll.Tail = nil, nil
-	} else if node == ll.Head {
+	return node
+}
+
+func (ll *LinkedList[T]) mustOwn(node *LinkedListNode[T]) {
+	if node == nil || node.List() != ll {
+		panic(fmt.Errorf("linked list node: %w", ErrForeignNode))
+	}
+}
+
+// Validate checks that links are symmetric, Head and Tail are the ends of the list,
+// every node belongs to the list and Len() matches the number of nodes.
+// It is meant for tests and debugging after the exported links were modified by hand.
+func (ll *LinkedList[T]) Validate() error {
+	if (ll.Head == nil) != (ll.Tail == nil) {
+		return fmt.Errorf("only one of head and tail is nil: %w", ErrCorruptedList)
+	}
+
+	var last *LinkedListNode[T]
+	count := 0
+
+	for node := ll.Head; node != nil; node = node.Next {
+		if count == ll.count {
+			return fmt.Errorf("more nodes than length %d: %w", ll.count, ErrCorruptedList)
+		}
+
+		if node.List() != ll {
+			return fmt.Errorf("node %d belongs to another list: %w", count, ErrCorruptedList)
+		}
+
+		if node.Prev != last {
+			return fmt.Errorf("node %d is not linked back to its predecessor: %w", count, ErrCorruptedList)
+		}
+
+		last = node
+		count++
+	}
+
+	if last != ll.Tail {
+		return fmt.Errorf("tail is not the last node: %w", ErrCorruptedList)
+	}
+
+	if count != ll.count {
+		return fmt.Errorf("%d nodes with length %d: %w", count, ll.count, ErrCorruptedList)
+	}
+
+	return nil
+}
+
+// link inserts node between prev and next, either of which is nil at the ends of the list.
+func (ll *LinkedList[T]) link(node, prev, next *LinkedListNode[T]) {
+	node.Prev, node.Next = prev, next
+	node.owner = ll.ownerToken()
+
+	if prev == nil {
+		ll.Head = node
+	} else {
+		prev.Next = node
+	}
+
+	if next == nil {
+		ll.Tail = node
+	} else {
+		next.Prev = node
+	}
+
+	ll.count++
+}
+
+// unlink removes node from the list and clears its links.
+func (ll *LinkedList[T]) unlink(node *LinkedListNode[T]) {
+	if node.Prev == nil {
 		ll.Head = node.Next
-		ll.Head.Prev = nil
-	} else if node == ll.Tail {
-		ll.Tail = node.Prev
-		ll.Tail.Next = nil
 	} else {
 		node.Prev.Next = node.Next
+	}
+
+	if node.Next == nil {
+		ll.Tail = node.Prev
+	} else {
 		node.Next.Prev = node.Prev
 	}
 
+	node.Next, node.Prev = nil, nil
+	node.owner = nil
 	ll.count--
+}
 
-	return node.Value
+func (ll *LinkedList[T]) Len() int {
+	return ll.count
+}
+
+func (ll *LinkedList[T]) Get(index int) T {
+	value, ok := ll.TryGet(index)
+	if !ok {
+		panic(indexOutOfRange(index, ll.count))
+	}
+
+	return value
+}
+
+func (ll *LinkedList[T]) TryGet(index int) (T, bool) {
+	var value T
+
+	if index < 0 || index >= ll.count {
+		return value, false
+	}
+
+	return ll.locate(index).Value, true
+}
+
+func (ll *LinkedList[T]) Set(index int, value T) {
+	if !ll.TrySet(index, value) {
+		panic(indexOutOfRange(index, ll.count))
+	}
+}
+
+func (ll *LinkedList[T]) TrySet(index int, value T) bool {
+	if index < 0 || index >= ll.count {
+		return false
+	}
+
+	ll.locate(index).Value = value
+
+	return true
+}
+
+func (ll *LinkedList[T]) Remove(index int) T {
+	value, ok := ll.TryRemove(index)
+	if !ok {
+		panic(indexOutOfRange(index, ll.count))
+	}
+
+	return value
+}
+
+func (ll *LinkedList[T]) TryRemove(index int) (T, bool) {
+	var value T
+
+	if index < 0 || index >= ll.count {
+		return value, false
+	}
+
+	node := ll.locate(index)
+	ll.unlink(node)
+
+	return node.Value, true
+}
+
+// AllNodes yields the nodes from head to tail.
+// Removing or moving the current node during iteration is safe and iteration
+// continues with its former successor. Nodes pushed to the back during iteration
+// are yielded too, and so is a node moved ahead of the iteration, even if it
+// was yielded before.
+func (ll *LinkedList[T]) AllNodes() iter.Seq[*LinkedListNode[T]] {
+	return func(yield func(*LinkedListNode[T]) bool) {
+		for node := ll.Head; node != nil; {
+			prev, next := node.Prev, node.Next
+			if !yield(node) {
+				return
+			}
+
+			// only a node left in place continues from its current successor,
+			// a removed or moved one continues from where it was
+			if node.List() == ll && node.Prev == prev {
+				next = node.Next
+			}
+			node = next
+		}
+	}
+}
+
+// All yields the values from head to tail, with the same
+// mutation guarantees as AllNodes.
+func (ll *LinkedList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for node := range ll.AllNodes() {
+			if !yield(node.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Indexed is like All but also yields the position of each value
+// counted from the head at the time it was reached.
+func (ll *LinkedList[T]) Indexed() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for node := range ll.AllNodes() {
+			if !yield(i, node.Value) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Backward yields the values from tail to head, with the same
+// mutation guarantees as AllNodes mirrored: removing or moving the current node
+// is safe and nodes pushed to the front during iteration are yielded too.
+func (ll *LinkedList[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, value := range ll.BackwardIndexed() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// BackwardIndexed is like Backward but also yields the position of each value,
+// counting down from Len()-1 as observed when iteration started.
+func (ll *LinkedList[T]) BackwardIndexed() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := ll.count - 1
+		for node := ll.Tail; node != nil; {
+			prev, next := node.Prev, node.Next
+			if !yield(i, node.Value) {
+				return
+			}
+
+			if node.List() == ll && node.Next == next {
+				prev = node.Prev
+			}
+			node = prev
+			i--
+		}
+	}
 }
@@ -1,7 +1,9 @@
 package collections
 
 import (
+	"errors"
 	"fmt"
+	"math/rand"
 	"slices"
 	"testing"
 
@@ -670,3 +672,1231 @@ func TestRemove(t *testing.T) {
 		})
 	}
 }
+
+func TestTryPop(t *testing.T) {
+	t.Run("empty list", func(t *testing.T) {
+		list := NewLinkedList[int]()
+
+		_, okFront := list.TryPopFront()
+		_, okBack := list.TryPopBack()
+
+		assert.False(t, okFront)
+		assert.False(t, okBack)
+	})
+
+	t.Run("non-empty list", func(t *testing.T) {
+		list := NewLinkedList[int]()
+		for _, element := range []int{5, 6, 7} {
+			list.PushBack(element)
+		}
+
+		front, okFront := list.TryPopFront()
+		back, okBack := list.TryPopBack()
+
+		assert.True(t, okFront)
+		assert.True(t, okBack)
+		assert.Equal(t, 5, front)
+		assert.Equal(t, 7, back)
+		assert.Equal(t, []int{6}, toSlice(list))
+	})
+}
+
+func TestTryIndexed(t *testing.T) {
+	type TestCase struct {
+		Name   string
+		List   []int
+		Index  int
+		Expect bool
+	}
+
+	testCases := []TestCase{
+		{
+			Name:   "empty list",
+			List:   []int{},
+			Index:  0,
+			Expect: false,
+		},
+		{
+			Name:   "negative index",
+			List:   []int{5, 6, 7},
+			Index:  -1,
+			Expect: false,
+		},
+		{
+			Name:   "out of bounds",
+			List:   []int{5, 6, 7},
+			Index:  3,
+			Expect: false,
+		},
+		{
+			Name:   "in bounds",
+			List:   []int{5, 6, 7},
+			Index:  1,
+			Expect: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			list := NewLinkedList[int]()
+			for _, element := range testCase.List {
+				list.PushBack(element)
+			}
+
+			value, okGet := list.TryGet(testCase.Index)
+			okSet := list.TrySet(testCase.Index, 42)
+			removed, okRemove := list.TryRemove(testCase.Index)
+
+			assert.Equal(t, testCase.Expect, okGet)
+			assert.Equal(t, testCase.Expect, okSet)
+			assert.Equal(t, testCase.Expect, okRemove)
+
+			if testCase.Expect {
+				assert.Equal(t, testCase.List[testCase.Index], value)
+				assert.Equal(t, 42, removed)
+				assert.Equal(t, len(testCase.List)-1, list.Len())
+			} else {
+				assert.Equal(t, testCase.List, toSlice(list))
+			}
+		})
+	}
+}
+
+func TestLinkedListPanicErrors(t *testing.T) {
+	type TestCase struct {
+		Name   string
+		Call   func(list *LinkedList[int])
+		Target error
+	}
+
+	testCases := []TestCase{
+		{
+			Name:   "pop front",
+			Call:   func(list *LinkedList[int]) { list.PopFront() },
+			Target: ErrEmpty,
+		},
+		{
+			Name:   "pop back",
+			Call:   func(list *LinkedList[int]) { list.PopBack() },
+			Target: ErrEmpty,
+		},
+		{
+			Name:   "get",
+			Call:   func(list *LinkedList[int]) { list.Get(0) },
+			Target: ErrIndexOutOfRange,
+		},
+		{
+			Name:   "set",
+			Call:   func(list *LinkedList[int]) { list.Set(0, 1) },
+			Target: ErrIndexOutOfRange,
+		},
+		{
+			Name:   "remove",
+			Call:   func(list *LinkedList[int]) { list.Remove(0) },
+			Target: ErrIndexOutOfRange,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			defer func() {
+				err, ok := recover().(error)
+				require.True(t, ok)
+				assert.True(t, errors.Is(err, testCase.Target))
+			}()
+
+			testCase.Call(NewLinkedList[int]())
+		})
+	}
+}
+
+func TestLinkedListIterators(t *testing.T) {
+	type TestCase[T any] struct {
+		Name string
+		List []T
+	}
+
+	testCases := []TestCase[int]{
+		{
+			Name: "empty list",
+			List: []int{},
+		},
+		{
+			Name: "single element",
+			List: []int{5},
+		},
+		{
+			Name: "three elements",
+			List: []int{5, 6, 7},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(fmt.Sprintf("forward %s", testCase.Name), func(t *testing.T) {
+			list := NewLinkedList[int]()
+			for _, element := range testCase.List {
+				list.PushBack(element)
+			}
+
+			actual := make([]int, 0)
+			for value := range list.All() {
+				actual = append(actual, value)
+			}
+
+			nodes := make([]int, 0)
+			for node := range list.AllNodes() {
+				nodes = append(nodes, node.Value)
+			}
+
+			for i, value := range list.Indexed() {
+				assert.Equal(t, testCase.List[i], value)
+			}
+
+			assert.Equal(t, testCase.List, actual)
+			assert.Equal(t, testCase.List, nodes)
+		})
+
+		t.Run(fmt.Sprintf("backward %s", testCase.Name), func(t *testing.T) {
+			list := NewLinkedList[int]()
+			for _, element := range testCase.List {
+				list.PushBack(element)
+			}
+
+			actual := make([]int, 0)
+			for value := range list.Backward() {
+				actual = append(actual, value)
+			}
+			slices.Reverse(actual)
+
+			for i, value := range list.BackwardIndexed() {
+				assert.Equal(t, testCase.List[i], value)
+			}
+
+			assert.Equal(t, testCase.List, actual)
+		})
+	}
+
+	t.Run("remove current node during iteration", func(t *testing.T) {
+		list := NewLinkedList[int]()
+		for _, element := range []int{1, 2, 3, 4, 5} {
+			list.PushBack(element)
+		}
+
+		visited := make([]int, 0)
+		removed := 0
+		for i, value := range list.Indexed() {
+			visited = append(visited, value)
+			if value%2 == 0 {
+				list.Remove(i - removed)
+				removed++
+			}
+		}
+
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, visited)
+		assert.Equal(t, []int{1, 3, 5}, toSlice(list))
+	})
+
+	t.Run("push back during iteration", func(t *testing.T) {
+		list := NewLinkedList[int]()
+		list.PushBack(1)
+
+		visited := make([]int, 0)
+		for value := range list.All() {
+			visited = append(visited, value)
+			if value < 3 {
+				list.PushBack(value + 1)
+			}
+		}
+
+		assert.Equal(t, []int{1, 2, 3}, visited)
+	})
+
+	t.Run("push front during backward iteration", func(t *testing.T) {
+		list := NewLinkedList[int]()
+		list.PushBack(3)
+
+		visited := make([]int, 0)
+		for value := range list.Backward() {
+			visited = append(visited, value)
+			if value > 1 {
+				list.PushFront(value - 1)
+			}
+		}
+
+		assert.Equal(t, []int{3, 2, 1}, visited)
+	})
+
+	t.Run("pop back during backward iteration", func(t *testing.T) {
+		list := NewLinkedList[int]()
+		for _, element := range []int{1, 2, 3} {
+			list.PushBack(element)
+		}
+
+		visited := make([]int, 0)
+		for value := range list.Backward() {
+			visited = append(visited, value)
+			list.PopBack()
+		}
+
+		assert.Equal(t, []int{3, 2, 1}, visited)
+		assert.Equal(t, 0, list.Len())
+	})
+
+	t.Run("move current node during iteration", func(t *testing.T) {
+		type TestCase struct {
+			Name     string
+			Move     func(list *LinkedList[int], node *LinkedListNode[int])
+			Visited  []int
+			Expected []int
+		}
+
+		moveCases := []TestCase{
+			{
+				Name:     "every node to front",
+				Move:     func(list *LinkedList[int], node *LinkedListNode[int]) { list.MoveToFront(node) },
+				Visited:  []int{1, 2, 3, 4, 5},
+				Expected: []int{5, 4, 3, 2, 1},
+			},
+			{
+				Name: "one node to back",
+				Move: func(list *LinkedList[int], node *LinkedListNode[int]) {
+					if node.Value == 2 {
+						list.MoveToBack(node)
+					}
+				},
+				Visited:  []int{1, 2, 3, 4, 5, 2},
+				Expected: []int{1, 3, 4, 5, 2},
+			},
+			{
+				Name: "one node before head",
+				Move: func(list *LinkedList[int], node *LinkedListNode[int]) {
+					if node.Value == 4 {
+						list.MoveBefore(node, list.Head)
+					}
+				},
+				Visited:  []int{1, 2, 3, 4, 5},
+				Expected: []int{4, 1, 2, 3, 5},
+			},
+			{
+				Name: "one node after tail",
+				Move: func(list *LinkedList[int], node *LinkedListNode[int]) {
+					if node.Value == 3 {
+						list.MoveAfter(node, list.Tail)
+					}
+				},
+				Visited:  []int{1, 2, 3, 4, 5, 3},
+				Expected: []int{1, 2, 4, 5, 3},
+			},
+		}
+
+		for _, moveCase := range moveCases {
+			t.Run(moveCase.Name, func(t *testing.T) {
+				list := newIntList(1, 2, 3, 4, 5)
+
+				visited := make([]int, 0)
+				for node := range list.AllNodes() {
+					visited = append(visited, node.Value)
+					moveCase.Move(list, node)
+				}
+
+				require.NoError(t, list.Validate())
+				assert.Equal(t, moveCase.Visited, visited)
+				assert.Equal(t, moveCase.Expected, toSlice(list))
+			})
+		}
+	})
+
+	t.Run("move current node during backward iteration", func(t *testing.T) {
+		list := newIntList(1, 2, 3, 4, 5)
+		nodes := slices.Collect(list.AllNodes())
+
+		visited := make([]int, 0)
+		for value := range list.Backward() {
+			visited = append(visited, value)
+			if value == 4 {
+				list.MoveToFront(nodes[3])
+			}
+			if value == 2 {
+				list.MoveToBack(nodes[1])
+			}
+		}
+
+		require.NoError(t, list.Validate())
+		assert.Equal(t, []int{5, 4, 3, 2, 1, 4}, visited)
+		assert.Equal(t, []int{4, 1, 3, 5, 2}, toSlice(list))
+	})
+
+	t.Run("break early", func(t *testing.T) {
+		list := NewLinkedList[int]()
+		for _, element := range []int{1, 2, 3} {
+			list.PushBack(element)
+		}
+
+		visited := make([]int, 0)
+		for value := range list.Backward() {
+			visited = append(visited, value)
+			break
+		}
+
+		assert.Equal(t, []int{3}, visited)
+	})
+}
+
+func toSliceBackward[T any](list *LinkedList[T]) []T {
+	slice := make([]T, 0, list.Len())
+
+	for p := list.Tail; p != nil; p = p.Prev {
+		slice = append(slice, p.Value)
+	}
+	slices.Reverse(slice)
+
+	return slice
+}
+
+func TestNodeOperations(t *testing.T) {
+	type TestCase struct {
+		Name     string
+		List     []int
+		Apply    func(list *LinkedList[int], nodes []*LinkedListNode[int])
+		Expected []int
+	}
+
+	testCases := []TestCase{
+		{
+			Name: "insert before head",
+			List: []int{1, 2},
+			Apply: func(list *LinkedList[int], nodes []*LinkedListNode[int]) {
+				list.InsertBefore(nodes[0], 0)
+			},
+			Expected: []int{0, 1, 2},
+		},
+		{
+			Name: "insert before middle",
+			List: []int{1, 3},
+			Apply: func(list *LinkedList[int], nodes []*LinkedListNode[int]) {
+				list.InsertBefore(nodes[1], 2)
+			},
+			Expected: []int{1, 2, 3},
+		},
+		{
+			Name: "insert after tail",
+			List: []int{1, 2},
+			Apply: func(list *LinkedList[int], nodes []*LinkedListNode[int]) {
+				list.InsertAfter(nodes[1], 3)
+			},
+			Expected: []int{1, 2, 3},
+		},
+		{
+			Name: "insert after middle",
+			List: []int{1, 3},
+			Apply: func(list *LinkedList[int], nodes []*LinkedListNode[int]) {
+				list.InsertAfter(nodes[0], 2)
+			},
+			Expected: []int{1, 2, 3},
+		},
+		{
+			Name: "remove only node",
+			List: []int{1},
+			Apply: func(list *LinkedList[int], nodes []*LinkedListNode[int]) {
+				list.RemoveNode(nodes[0])
+			},
+			Expected: []int{},
+		},
+		{
+			Name: "remove head, middle and tail",
+			List: []int{1, 2, 3, 4, 5},
+			Apply: func(list *LinkedList[int], nodes []*LinkedListNode[int]) {
+				list.RemoveNode(nodes[0])
+				list.RemoveNode(nodes[2])
+				list.RemoveNode(nodes[4])
+			},
+			Expected: []int{2, 4},
+		},
+		{
+			Name: "move to front",
+			List: []int{1, 2, 3},
+			Apply: func(list *LinkedList[int], nodes []*LinkedListNode[int]) {
+				list.MoveToFront(nodes[2])
+				list.MoveToFront(nodes[2])
+			},
+			Expected: []int{3, 1, 2},
+		},
+		{
+			Name: "move to back",
+			List: []int{1, 2, 3},
+			Apply: func(list *LinkedList[int], nodes []*LinkedListNode[int]) {
+				list.MoveToBack(nodes[0])
+				list.MoveToBack(nodes[0])
+			},
+			Expected: []int{2, 3, 1},
+		},
+		{
+			Name: "move before",
+			List: []int{1, 2, 3, 4},
+			Apply: func(list *LinkedList[int], nodes []*LinkedListNode[int]) {
+				list.MoveBefore(nodes[3], nodes[0])
+				list.MoveBefore(nodes[1], nodes[2])
+				list.MoveBefore(nodes[1], nodes[1])
+			},
+			Expected: []int{4, 1, 2, 3},
+		},
+		{
+			Name: "move after",
+			List: []int{1, 2, 3, 4},
+			Apply: func(list *LinkedList[int], nodes []*LinkedListNode[int]) {
+				list.MoveAfter(nodes[0], nodes[3])
+				list.MoveAfter(nodes[2], nodes[1])
+				list.MoveAfter(nodes[2], nodes[2])
+			},
+			Expected: []int{2, 3, 4, 1},
+		},
+		{
+			Name: "swap neighbours",
+			List: []int{1, 2},
+			Apply: func(list *LinkedList[int], nodes []*LinkedListNode[int]) {
+				list.MoveAfter(nodes[0], nodes[1])
+			},
+			Expected: []int{2, 1},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			list := NewLinkedList[int]()
+			nodes := make([]*LinkedListNode[int], 0, len(testCase.List))
+			for _, element := range testCase.List {
+				nodes = append(nodes, list.PushBack(element))
+			}
+
+			testCase.Apply(list, nodes)
+
+			require.NoError(t, list.Validate())
+			assert.Equal(t, testCase.Expected, toSlice(list))
+			assert.Equal(t, testCase.Expected, toSliceBackward(list))
+			assert.Equal(t, len(testCase.Expected), list.Len())
+		})
+	}
+}
+
+func TestPushReturnsNode(t *testing.T) {
+	list := NewLinkedList[int]()
+
+	back := list.PushBack(2)
+	front := list.PushFront(1)
+
+	assert.Same(t, list.Head, front)
+	assert.Same(t, list.Tail, back)
+	assert.Equal(t, 2, list.RemoveNode(back))
+	assert.Nil(t, back.Next)
+	assert.Nil(t, back.Prev)
+	assert.Equal(t, []int{1}, toSlice(list))
+
+	require.Panics(t, func() {
+		list.RemoveNode(nil)
+	})
+}
+
+func TestNodeOwnership(t *testing.T) {
+	list := NewLinkedList[int]()
+	other := NewLinkedList[int]()
+	node := list.PushBack(1)
+	foreign := other.PushBack(2)
+
+	assert.Same(t, list, node.List())
+	assert.Same(t, other, foreign.List())
+
+	type TestCase struct {
+		Name string
+		Call func()
+	}
+
+	removed := list.PushBack(3)
+	list.RemoveNode(removed)
+	popped := list.PushFront(0)
+	list.PopFront()
+
+	testCases := []TestCase{
+		{
+			Name: "nil node",
+			Call: func() { list.RemoveNode(nil) },
+		},
+		{
+			Name: "remove foreign node",
+			Call: func() { list.RemoveNode(foreign) },
+		},
+		{
+			Name: "insert after foreign node",
+			Call: func() { list.InsertAfter(foreign, 5) },
+		},
+		{
+			Name: "move foreign node",
+			Call: func() { list.MoveToFront(foreign) },
+		},
+		{
+			Name: "move before foreign mark",
+			Call: func() { list.MoveBefore(node, foreign) },
+		},
+		{
+			Name: "remove removed node",
+			Call: func() { list.RemoveNode(removed) },
+		},
+		{
+			Name: "insert before popped node",
+			Call: func() { list.InsertBefore(popped, 5) },
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			defer func() {
+				err, ok := recover().(error)
+				require.True(t, ok)
+				assert.True(t, errors.Is(err, ErrForeignNode))
+			}()
+
+			testCase.Call()
+		})
+	}
+
+	assert.Nil(t, removed.List())
+	assert.Nil(t, popped.List())
+	assert.Equal(t, []int{1}, toSlice(list))
+	assert.Equal(t, []int{2}, toSlice(other))
+	assert.NoError(t, list.Validate())
+	assert.NoError(t, other.Validate())
+}
+
+func TestValidate(t *testing.T) {
+	type TestCase struct {
+		Name    string
+		Corrupt func(list *LinkedList[int], other *LinkedList[int])
+	}
+
+	testCases := []TestCase{
+		{
+			Name: "broken back link",
+			Corrupt: func(list *LinkedList[int], other *LinkedList[int]) {
+				list.Head.Next.Prev = nil
+			},
+		},
+		{
+			Name: "head with predecessor",
+			Corrupt: func(list *LinkedList[int], other *LinkedList[int]) {
+				list.Head.Prev = list.Tail
+			},
+		},
+		{
+			Name: "tail not last",
+			Corrupt: func(list *LinkedList[int], other *LinkedList[int]) {
+				list.Tail = list.Head
+			},
+		},
+		{
+			Name: "nil tail",
+			Corrupt: func(list *LinkedList[int], other *LinkedList[int]) {
+				list.Tail = nil
+			},
+		},
+		{
+			Name: "skipped node",
+			Corrupt: func(list *LinkedList[int], other *LinkedList[int]) {
+				list.Head.Next = list.Tail
+				list.Tail.Prev = list.Head
+			},
+		},
+		{
+			Name: "cycle",
+			Corrupt: func(list *LinkedList[int], other *LinkedList[int]) {
+				list.Tail.Next = list.Head
+			},
+		},
+		{
+			Name: "foreign node linked in",
+			Corrupt: func(list *LinkedList[int], other *LinkedList[int]) {
+				foreign := other.Head
+				foreign.Prev = list.Head
+				foreign.Next = list.Head.Next
+				list.Head.Next.Prev = foreign
+				list.Head.Next = foreign
+				list.Tail.Prev.Next = nil
+				list.Tail = list.Tail.Prev
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			list := NewLinkedList[int]()
+			other := NewLinkedList[int]()
+			for _, element := range []int{1, 2, 3} {
+				list.PushBack(element)
+			}
+			other.PushBack(4)
+			require.NoError(t, list.Validate())
+
+			testCase.Corrupt(list, other)
+
+			assert.ErrorIs(t, list.Validate(), ErrCorruptedList)
+		})
+	}
+
+	t.Run("empty list", func(t *testing.T) {
+		assert.NoError(t, NewLinkedList[int]().Validate())
+
+		var list LinkedList[int]
+		list.PushBack(1)
+		assert.NoError(t, list.Validate())
+	})
+}
+
+func TestIndexedAccessFromBothEnds(t *testing.T) {
+	for _, length := range []int{1, 2, 3, 4, 5, 10, 11} {
+		t.Run(fmt.Sprintf("length %d", length), func(t *testing.T) {
+			list := NewLinkedList[int]()
+			for i := 0; i < length; i++ {
+				list.PushBack(i)
+			}
+
+			for i := 0; i < length; i++ {
+				require.Equal(t, i, list.Get(i))
+				list.Set(i, 10*i)
+			}
+
+			for i := 0; i < length; i++ {
+				require.Equal(t, 10*i, list.Get(i))
+			}
+		})
+	}
+}
+
+func TestInsert(t *testing.T) {
+	type TestCase struct {
+		Name     string
+		List     []int
+		Index    int
+		Expected []int
+	}
+
+	testCases := []TestCase{
+		{
+			Name:     "empty list",
+			List:     []int{},
+			Index:    0,
+			Expected: []int{9},
+		},
+		{
+			Name:     "front",
+			List:     []int{1, 2, 3},
+			Index:    0,
+			Expected: []int{9, 1, 2, 3},
+		},
+		{
+			Name:     "middle near head",
+			List:     []int{1, 2, 3, 4, 5},
+			Index:    1,
+			Expected: []int{1, 9, 2, 3, 4, 5},
+		},
+		{
+			Name:     "middle near tail",
+			List:     []int{1, 2, 3, 4, 5},
+			Index:    4,
+			Expected: []int{1, 2, 3, 4, 9, 5},
+		},
+		{
+			Name:     "back",
+			List:     []int{1, 2, 3},
+			Index:    3,
+			Expected: []int{1, 2, 3, 9},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			list := NewLinkedList[int]()
+			for _, element := range testCase.List {
+				list.PushBack(element)
+			}
+
+			node := list.Insert(testCase.Index, 9)
+
+			require.NoError(t, list.Validate())
+			assert.Equal(t, 9, node.Value)
+			assert.Equal(t, testCase.Expected, toSlice(list))
+			assert.Equal(t, testCase.Expected, toSliceBackward(list))
+		})
+	}
+
+	for _, index := range []int{-1, 4} {
+		t.Run(fmt.Sprintf("out of range %d", index), func(t *testing.T) {
+			list := NewLinkedList[int]()
+			for _, element := range []int{1, 2, 3} {
+				list.PushBack(element)
+			}
+
+			node, ok := list.TryInsert(index, 9)
+
+			assert.False(t, ok)
+			assert.Nil(t, node)
+			assert.Equal(t, []int{1, 2, 3}, toSlice(list))
+
+			defer func() {
+				err, ok := recover().(error)
+				require.True(t, ok)
+				assert.True(t, errors.Is(err, ErrIndexOutOfRange))
+			}()
+
+			list.Insert(index, 9)
+		})
+	}
+}
+
+func TestRemoveRange(t *testing.T) {
+	type TestCase struct {
+		Name     string
+		From     int
+		To       int
+		Expected []int
+	}
+
+	testCases := []TestCase{
+		{
+			Name:     "empty range",
+			From:     2,
+			To:       2,
+			Expected: []int{0, 1, 2, 3, 4},
+		},
+		{
+			Name:     "prefix",
+			From:     0,
+			To:       2,
+			Expected: []int{2, 3, 4},
+		},
+		{
+			Name:     "middle",
+			From:     1,
+			To:       4,
+			Expected: []int{0, 4},
+		},
+		{
+			Name:     "suffix",
+			From:     3,
+			To:       5,
+			Expected: []int{0, 1, 2},
+		},
+		{
+			Name:     "everything",
+			From:     0,
+			To:       5,
+			Expected: []int{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			list := NewLinkedList[int]()
+			nodes := make([]*LinkedListNode[int], 0)
+			for i := 0; i < 5; i++ {
+				nodes = append(nodes, list.PushBack(i))
+			}
+
+			list.RemoveRange(testCase.From, testCase.To)
+
+			require.NoError(t, list.Validate())
+			assert.Equal(t, testCase.Expected, toSlice(list))
+			assert.Equal(t, testCase.Expected, toSliceBackward(list))
+			for i := testCase.From; i < testCase.To; i++ {
+				assert.Nil(t, nodes[i].List())
+			}
+		})
+	}
+
+	for _, bounds := range [][2]int{{-1, 2}, {0, 6}, {3, 2}} {
+		t.Run(fmt.Sprintf("invalid range %v", bounds), func(t *testing.T) {
+			require.Panics(t, func() {
+				list := NewLinkedList[int]()
+				for i := 0; i < 5; i++ {
+					list.PushBack(i)
+				}
+
+				list.RemoveRange(bounds[0], bounds[1])
+			})
+		})
+	}
+}
+
+func newIntList(values ...int) *LinkedList[int] {
+	list := NewLinkedList[int]()
+	for _, value := range values {
+		list.PushBack(value)
+	}
+
+	return list
+}
+
+func TestSplice(t *testing.T) {
+	type TestCase struct {
+		Name     string
+		List     []int
+		Other    []int
+		Splice   func(list, other *LinkedList[int])
+		Expected []int
+	}
+
+	testCases := []TestCase{
+		{
+			Name:     "push back list",
+			List:     []int{1, 2},
+			Other:    []int{3, 4},
+			Splice:   (*LinkedList[int]).PushBackList,
+			Expected: []int{1, 2, 3, 4},
+		},
+		{
+			Name:     "push front list",
+			List:     []int{3, 4},
+			Other:    []int{1, 2},
+			Splice:   (*LinkedList[int]).PushFrontList,
+			Expected: []int{1, 2, 3, 4},
+		},
+		{
+			Name:     "push back into empty list",
+			List:     []int{},
+			Other:    []int{1, 2},
+			Splice:   (*LinkedList[int]).PushBackList,
+			Expected: []int{1, 2},
+		},
+		{
+			Name:     "push back empty list",
+			List:     []int{1, 2},
+			Other:    []int{},
+			Splice:   (*LinkedList[int]).PushBackList,
+			Expected: []int{1, 2},
+		},
+		{
+			Name:  "splice after middle node",
+			List:  []int{1, 4},
+			Other: []int{2, 3},
+			Splice: func(list, other *LinkedList[int]) {
+				list.SpliceAfter(list.Head, other)
+			},
+			Expected: []int{1, 2, 3, 4},
+		},
+		{
+			Name:  "splice after tail",
+			List:  []int{1, 2},
+			Other: []int{3},
+			Splice: func(list, other *LinkedList[int]) {
+				list.SpliceAfter(list.Tail, other)
+			},
+			Expected: []int{1, 2, 3},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			list := newIntList(testCase.List...)
+			other := newIntList(testCase.Other...)
+			moved := make([]*LinkedListNode[int], 0)
+			for node := range other.AllNodes() {
+				moved = append(moved, node)
+			}
+
+			testCase.Splice(list, other)
+
+			require.NoError(t, list.Validate())
+			require.NoError(t, other.Validate())
+			assert.Equal(t, testCase.Expected, toSlice(list))
+			assert.Equal(t, testCase.Expected, toSliceBackward(list))
+			assert.Equal(t, len(testCase.Expected), list.Len())
+			assert.Equal(t, 0, other.Len())
+			for _, node := range moved {
+				assert.Same(t, list, node.List())
+			}
+		})
+	}
+
+	t.Run("into itself", func(t *testing.T) {
+		list := newIntList(1, 2)
+
+		require.Panics(t, func() { list.PushBackList(list) })
+	})
+
+	t.Run("after foreign node", func(t *testing.T) {
+		list := newIntList(1, 2)
+		other := newIntList(3)
+
+		require.PanicsWithError(t, "linked list node: "+ErrForeignNode.Error(), func() {
+			list.SpliceAfter(other.Head, newIntList(4))
+		})
+	})
+}
+
+func TestSpliceChain(t *testing.T) {
+	first := newIntList(1)
+	second := newIntList(2)
+	third := newIntList(3)
+	node := first.Head
+
+	second.PushFrontList(first)
+	third.PushFrontList(second)
+
+	require.NoError(t, third.Validate())
+	assert.Same(t, third, node.List())
+	assert.Equal(t, []int{1, 2, 3}, toSlice(third))
+
+	// the emptied lists are usable and independent afterwards
+	first.PushBack(4)
+	require.NoError(t, first.Validate())
+	require.NoError(t, third.Validate())
+	assert.Equal(t, []int{4}, toSlice(first))
+
+	third.RemoveNode(node)
+	assert.Nil(t, node.List())
+	assert.Equal(t, []int{2, 3}, toSlice(third))
+}
+
+func TestSplit(t *testing.T) {
+	type TestCase struct {
+		Name     string
+		Split    func(list *LinkedList[int]) *LinkedList[int]
+		Expected []int
+		Rest     []int
+	}
+
+	testCases := []TestCase{
+		{
+			Name:     "at start",
+			Split:    func(list *LinkedList[int]) *LinkedList[int] { return list.SplitAt(0) },
+			Expected: []int{},
+			Rest:     []int{0, 1, 2, 3},
+		},
+		{
+			Name:     "at middle",
+			Split:    func(list *LinkedList[int]) *LinkedList[int] { return list.SplitAt(1) },
+			Expected: []int{0},
+			Rest:     []int{1, 2, 3},
+		},
+		{
+			Name:     "at end",
+			Split:    func(list *LinkedList[int]) *LinkedList[int] { return list.SplitAt(4) },
+			Expected: []int{0, 1, 2, 3},
+			Rest:     []int{},
+		},
+		{
+			Name:     "after head",
+			Split:    func(list *LinkedList[int]) *LinkedList[int] { return list.SplitAfter(list.Head) },
+			Expected: []int{0},
+			Rest:     []int{1, 2, 3},
+		},
+		{
+			Name:     "after tail",
+			Split:    func(list *LinkedList[int]) *LinkedList[int] { return list.SplitAfter(list.Tail) },
+			Expected: []int{0, 1, 2, 3},
+			Rest:     []int{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			list := newIntList(0, 1, 2, 3)
+			nodes := make([]*LinkedListNode[int], 0)
+			for node := range list.AllNodes() {
+				nodes = append(nodes, node)
+			}
+
+			rest := testCase.Split(list)
+
+			require.NoError(t, list.Validate())
+			require.NoError(t, rest.Validate())
+			assert.Equal(t, testCase.Expected, toSlice(list))
+			assert.Equal(t, testCase.Expected, toSliceBackward(list))
+			assert.Equal(t, testCase.Rest, toSlice(rest))
+			assert.Equal(t, testCase.Rest, toSliceBackward(rest))
+			assert.Equal(t, len(testCase.Expected), list.Len())
+			assert.Equal(t, len(testCase.Rest), rest.Len())
+			for _, node := range nodes[:len(testCase.Expected)] {
+				assert.Same(t, list, node.List())
+			}
+			for _, node := range nodes[len(testCase.Expected):] {
+				assert.Same(t, rest, node.List())
+			}
+		})
+	}
+
+	for _, index := range []int{-1, 5} {
+		t.Run(fmt.Sprintf("at invalid index %d", index), func(t *testing.T) {
+			list := newIntList(0, 1, 2, 3)
+
+			require.Panics(t, func() { list.SplitAt(index) })
+		})
+	}
+}
+
+func TestSort(t *testing.T) {
+	type item struct {
+		Key   int
+		Order int
+	}
+
+	compareKeys := func(a, b item) int {
+		return a.Key - b.Key
+	}
+
+	rnd := rand.New(rand.NewSource(42))
+	for _, size := range []int{0, 1, 2, 3, 7, 8, 9, 100, 1000} {
+		t.Run(fmt.Sprintf("%d elements", size), func(t *testing.T) {
+			list := NewLinkedList[item]()
+			nodes := make(map[item]*LinkedListNode[item])
+			for i := 0; i < size; i++ {
+				value := item{Key: rnd.Intn(size/4 + 1), Order: i}
+				nodes[value] = list.PushBack(value)
+			}
+
+			expected := toSlice(list)
+			slices.SortStableFunc(expected, compareKeys)
+
+			list.Sort(compareKeys)
+
+			require.NoError(t, list.Validate())
+			assert.Equal(t, expected, toSlice(list))
+			assert.Equal(t, expected, toSliceBackward(list))
+			assert.True(t, list.IsSorted(compareKeys))
+			for node := range list.AllNodes() {
+				assert.Same(t, nodes[node.Value], node)
+			}
+		})
+	}
+
+	t.Run("does not allocate", func(t *testing.T) {
+		list := NewLinkedList[int]()
+		for i := 0; i < 100; i++ {
+			list.PushBack(rnd.Intn(100))
+		}
+
+		descending := Reverse(intCompare)
+		allocs := testing.AllocsPerRun(10, func() {
+			list.Sort(descending)
+			list.Sort(intCompare)
+		})
+
+		assert.Zero(t, allocs)
+	})
+}
+
+func TestIsSorted(t *testing.T) {
+	type TestCase struct {
+		Name     string
+		List     []int
+		Expected bool
+	}
+
+	testCases := []TestCase{
+		{Name: "empty", List: []int{}, Expected: true},
+		{Name: "single", List: []int{1}, Expected: true},
+		{Name: "with duplicates", List: []int{1, 2, 2, 3}, Expected: true},
+		{Name: "unsorted tail", List: []int{1, 2, 4, 3}, Expected: false},
+		{Name: "descending", List: []int{3, 2, 1}, Expected: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			list := newIntList(testCase.List...)
+
+			assert.Equal(t, testCase.Expected, list.IsSorted(intCompare))
+		})
+	}
+}
+
+func TestInsertSorted(t *testing.T) {
+	type TestCase struct {
+		Name     string
+		List     []int
+		Value    int
+		Expected []int
+		Index    int
+	}
+
+	testCases := []TestCase{
+		{Name: "into empty list", List: []int{}, Value: 1, Expected: []int{1}, Index: 0},
+		{Name: "at front", List: []int{2, 3}, Value: 1, Expected: []int{1, 2, 3}, Index: 0},
+		{Name: "in middle", List: []int{1, 3}, Value: 2, Expected: []int{1, 2, 3}, Index: 1},
+		{Name: "at back", List: []int{1, 2}, Value: 3, Expected: []int{1, 2, 3}, Index: 2},
+		{Name: "after equal elements", List: []int{1, 2, 2, 3}, Value: 2, Expected: []int{1, 2, 2, 2, 3}, Index: 3},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			list := newIntList(testCase.List...)
+
+			node := list.InsertSorted(testCase.Value, intCompare)
+
+			require.NoError(t, list.Validate())
+			assert.Equal(t, testCase.Expected, toSlice(list))
+			assert.Equal(t, testCase.Expected, toSliceBackward(list))
+
+			index := 0
+			for current := range list.AllNodes() {
+				if current == node {
+					break
+				}
+				index++
+			}
+			assert.Equal(t, testCase.Index, index)
+		})
+	}
+}
+
+func TestMergeSorted(t *testing.T) {
+	type TestCase struct {
+		Name     string
+		List     []int
+		Other    []int
+		Expected []int
+	}
+
+	testCases := []TestCase{
+		{Name: "both empty", List: []int{}, Other: []int{}, Expected: []int{}},
+		{Name: "into empty", List: []int{}, Other: []int{1, 2}, Expected: []int{1, 2}},
+		{Name: "empty other", List: []int{1, 2}, Other: []int{}, Expected: []int{1, 2}},
+		{Name: "interleaved", List: []int{1, 3, 5}, Other: []int{2, 4, 6, 7}, Expected: []int{1, 2, 3, 4, 5, 6, 7}},
+		{Name: "other before", List: []int{4, 5}, Other: []int{1, 2}, Expected: []int{1, 2, 4, 5}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			list := newIntList(testCase.List...)
+			other := newIntList(testCase.Other...)
+			moved := make([]*LinkedListNode[int], 0)
+			for node := range other.AllNodes() {
+				moved = append(moved, node)
+			}
+
+			list.MergeSorted(other, intCompare)
+
+			require.NoError(t, list.Validate())
+			require.NoError(t, other.Validate())
+			assert.Equal(t, testCase.Expected, toSlice(list))
+			assert.Equal(t, testCase.Expected, toSliceBackward(list))
+			assert.Equal(t, 0, other.Len())
+			for _, node := range moved {
+				assert.Same(t, list, node.List())
+			}
+		})
+	}
+
+	t.Run("keeps list elements before equal ones of other", func(t *testing.T) {
+		list := newIntList(1, 2)
+		other := newIntList(2, 3)
+		first := list.Tail
+		second := other.Head
+
+		list.MergeSorted(other, intCompare)
+
+		assert.Same(t, first.Next, second)
+	})
+
+	t.Run("with itself", func(t *testing.T) {
+		list := newIntList(1, 2)
+
+		require.Panics(t, func() { list.MergeSorted(list, intCompare) })
+	})
+}
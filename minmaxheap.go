@@ -0,0 +1,244 @@
+package collections
+
+import (
+	"fmt"
+	"iter"
+	"math/bits"
+)
+
+// MinMaxHeap is a double-ended priority queue giving O(1) access to both
+// the smallest and the largest element. Levels of the underlying binary tree
+// alternate between min levels, starting with the root, and max levels:
+// every element on a min level is the smallest of its subtree
+// and every element on a max level is the largest of its subtree.
+type MinMaxHeap[T any] struct {
+	slice   []T
+	compare func(T, T) int
+}
+
+func NewMinMaxHeap[T any](compare func(T, T) int) *MinMaxHeap[T] {
+	heap := MinMaxHeap[T]{
+		slice:   make([]T, 0),
+		compare: compare,
+	}
+
+	return &heap
+}
+
+func (h *MinMaxHeap[T]) Push(value T) {
+	h.slice = append(h.slice, value)
+	h.bubbleUp(len(h.slice) - 1)
+}
+
+func (h *MinMaxHeap[T]) PopMin() T {
+	value, ok := h.TryPopMin()
+	if !ok {
+		panic(fmt.Errorf("pop from empty heap: %w", ErrEmpty))
+	}
+
+	return value
+}
+
+func (h *MinMaxHeap[T]) TryPopMin() (T, bool) {
+	var value T
+
+	if len(h.slice) == 0 {
+		return value, false
+	}
+
+	return h.removeAt(0), true
+}
+
+func (h *MinMaxHeap[T]) PopMax() T {
+	value, ok := h.TryPopMax()
+	if !ok {
+		panic(fmt.Errorf("pop from empty heap: %w", ErrEmpty))
+	}
+
+	return value
+}
+
+func (h *MinMaxHeap[T]) TryPopMax() (T, bool) {
+	var value T
+
+	if len(h.slice) == 0 {
+		return value, false
+	}
+
+	return h.removeAt(h.maxIndex()), true
+}
+
+func (h *MinMaxHeap[T]) PeekMin() T {
+	value, ok := h.TryPeekMin()
+	if !ok {
+		panic(fmt.Errorf("peek from empty heap: %w", ErrEmpty))
+	}
+
+	return value
+}
+
+func (h *MinMaxHeap[T]) TryPeekMin() (T, bool) {
+	var value T
+
+	if len(h.slice) == 0 {
+		return value, false
+	}
+
+	return h.slice[0], true
+}
+
+func (h *MinMaxHeap[T]) PeekMax() T {
+	value, ok := h.TryPeekMax()
+	if !ok {
+		panic(fmt.Errorf("peek from empty heap: %w", ErrEmpty))
+	}
+
+	return value
+}
+
+func (h *MinMaxHeap[T]) TryPeekMax() (T, bool) {
+	var value T
+
+	if len(h.slice) == 0 {
+		return value, false
+	}
+
+	return h.slice[h.maxIndex()], true
+}
+
+func (h *MinMaxHeap[T]) Len() int {
+	return len(h.slice)
+}
+
+// All yields the elements in unspecified order, with the same
+// mutation guarantees as Heap.All.
+func (h *MinMaxHeap[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < len(h.slice); i++ {
+			if !yield(h.slice[i]) {
+				return
+			}
+		}
+	}
+}
+
+// maxIndex returns the index of the largest element of a non-empty heap,
+// which is either the root or one of its children.
+func (h *MinMaxHeap[T]) maxIndex() int {
+	switch len(h.slice) {
+	case 1:
+		return 0
+	case 2:
+		return 1
+	}
+
+	if h.compare(h.slice[2], h.slice[1]) > 0 {
+		return 2
+	}
+
+	return 1
+}
+
+func (h *MinMaxHeap[T]) removeAt(index int) T {
+	var zero T
+
+	last := len(h.slice) - 1
+	value := h.slice[index]
+	h.slice[index] = h.slice[last]
+	h.slice[last] = zero
+	h.slice = h.slice[:last]
+
+	if index < last {
+		h.trickleDown(index)
+	}
+
+	return value
+}
+
+// minMaxLevelSign returns 1 on min levels and -1 on max levels, so that
+// order * compare(a, b) < 0 means a belongs closer to the root than b.
+func minMaxLevelSign(index int) int {
+	if (bits.Len(uint(index+1))-1)%2 == 0 {
+		return 1
+	}
+
+	return -1
+}
+
+func minMaxParent(index int) int {
+	return (index - 1) / 2
+}
+
+func minMaxFirstChild(index int) int {
+	return 2*index + 1
+}
+
+func (h *MinMaxHeap[T]) before(i, j, sign int) bool {
+	return sign*h.compare(h.slice[i], h.slice[j]) < 0
+}
+
+func (h *MinMaxHeap[T]) bubbleUp(index int) {
+	if index == 0 {
+		return
+	}
+
+	sign := minMaxLevelSign(index)
+	p := minMaxParent(index)
+
+	// an element that belongs to the opposite kind of level than its own
+	// is swapped with its parent and continues on the parent's levels
+	if h.before(p, index, sign) {
+		h.slice[index], h.slice[p] = h.slice[p], h.slice[index]
+		index, sign = p, -sign
+	}
+
+	for index > 2 {
+		gp := minMaxParent(minMaxParent(index))
+		if !h.before(index, gp, sign) {
+			break
+		}
+
+		h.slice[index], h.slice[gp] = h.slice[gp], h.slice[index]
+		index = gp
+	}
+}
+
+func (h *MinMaxHeap[T]) trickleDown(index int) {
+	sign := minMaxLevelSign(index)
+
+	for {
+		first := minMaxFirstChild(index)
+		if first >= len(h.slice) {
+			return
+		}
+
+		// m is the first element in level order among children and grandchildren
+		m := first
+		if first+1 < len(h.slice) && h.before(first+1, m, sign) {
+			m = first + 1
+		}
+
+		for gc := minMaxFirstChild(first); gc < min(minMaxFirstChild(first)+4, len(h.slice)); gc++ {
+			if h.before(gc, m, sign) {
+				m = gc
+			}
+		}
+
+		if !h.before(m, index, sign) {
+			return
+		}
+
+		h.slice[index], h.slice[m] = h.slice[m], h.slice[index]
+
+		if m <= first+1 {
+			return
+		}
+
+		// a grandchild swapped down may now belong above its new parent
+		if p := minMaxParent(m); h.before(p, m, sign) {
+			h.slice[m], h.slice[p] = h.slice[p], h.slice[m]
+		}
+
+		index = m
+	}
+}
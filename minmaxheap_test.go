@@ -0,0 +1,136 @@
+package collections
+
+import (
+	"errors"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMinMaxHeap(t *testing.T) {
+	type TestCase struct {
+		Name  string
+		Input []int
+	}
+
+	var largeSlice []int
+	for i := 0; i < 10000; i++ {
+		largeSlice = append(largeSlice, rand.Intn(10000))
+	}
+
+	testCases := []TestCase{
+		{
+			Name:  "single element",
+			Input: []int{1},
+		},
+		{
+			Name:  "two elements",
+			Input: []int{2, 1},
+		},
+		{
+			Name:  "three elements",
+			Input: []int{2, 1, 3},
+		},
+		{
+			Name:  "large slice",
+			Input: largeSlice,
+		},
+	}
+
+	for _, testCase := range testCases {
+		expected := slices.Sorted(slices.Values(testCase.Input))
+
+		t.Run(testCase.Name+" pop min", func(t *testing.T) {
+			heap := NewMinMaxHeap(intCompare)
+			for _, num := range testCase.Input {
+				heap.Push(num)
+			}
+
+			actual := make([]int, 0, len(testCase.Input))
+			for heap.Len() > 0 {
+				actual = append(actual, heap.PopMin())
+			}
+
+			assert.Equal(t, expected, actual)
+		})
+
+		t.Run(testCase.Name+" pop max", func(t *testing.T) {
+			heap := NewMinMaxHeap(intCompare)
+			for _, num := range testCase.Input {
+				heap.Push(num)
+			}
+
+			actual := make([]int, 0, len(testCase.Input))
+			for heap.Len() > 0 {
+				actual = append(actual, heap.PopMax())
+			}
+			slices.Reverse(actual)
+
+			assert.Equal(t, expected, actual)
+		})
+	}
+}
+
+func TestMinMaxHeapAgainstReference(t *testing.T) {
+	rnd := rand.New(rand.NewSource(11))
+	heap := NewMinMaxHeap(intCompare)
+	reference := make([]int, 0)
+
+	for i := 0; i < 50000; i++ {
+		switch op := rnd.Intn(4); {
+		case op < 2 || len(reference) == 0:
+			value := rnd.Intn(1000)
+			heap.Push(value)
+			reference = append(reference, value)
+			slices.Sort(reference)
+		case op == 2:
+			require.Equal(t, reference[0], heap.PopMin())
+			reference = reference[1:]
+		case op == 3:
+			require.Equal(t, reference[len(reference)-1], heap.PopMax())
+			reference = reference[:len(reference)-1]
+		}
+
+		require.Equal(t, len(reference), heap.Len())
+		if len(reference) > 0 {
+			require.Equal(t, reference[0], heap.PeekMin())
+			require.Equal(t, reference[len(reference)-1], heap.PeekMax())
+		}
+	}
+
+	assert.Equal(t, reference, slices.Sorted(heap.All()))
+}
+
+func TestMinMaxHeapEmpty(t *testing.T) {
+	heap := NewMinMaxHeap(intCompare)
+
+	_, okPopMin := heap.TryPopMin()
+	_, okPopMax := heap.TryPopMax()
+	_, okPeekMin := heap.TryPeekMin()
+	_, okPeekMax := heap.TryPeekMax()
+
+	assert.False(t, okPopMin)
+	assert.False(t, okPopMax)
+	assert.False(t, okPeekMin)
+	assert.False(t, okPeekMax)
+
+	for _, call := range []func(){
+		func() { heap.PopMin() },
+		func() { heap.PopMax() },
+		func() { heap.PeekMin() },
+		func() { heap.PeekMax() },
+	} {
+		func() {
+			defer func() {
+				err, ok := recover().(error)
+				require.True(t, ok)
+				assert.True(t, errors.Is(err, ErrEmpty))
+			}()
+
+			call()
+		}()
+	}
+}
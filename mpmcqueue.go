@@ -0,0 +1,148 @@
+package collections
+
+import (
+	"fmt"
+	"math/bits"
+	"sync/atomic"
+)
+
+const cacheLineSize = 64
+
+type cacheLinePad [cacheLineSize]byte
+
+type mpmcCell[T any] struct {
+	// sequence equals the position a producer may claim the cell for,
+	// or that position plus one once the value is ready for a consumer
+	sequence atomic.Uint64
+	value    T
+}
+
+// MPMCQueue is a lock-free bounded queue safe for any number of concurrent
+// producers and consumers. It is Dmitry Vyukov's sequence-numbered ring buffer:
+// each cell carries a sequence number telling producers and consumers
+// whether it is theirs to claim with a single compare-and-swap.
+type MPMCQueue[T any] struct {
+	_          cacheLinePad
+	enqueuePos atomic.Uint64
+	_          cacheLinePad
+	dequeuePos atomic.Uint64
+	_          cacheLinePad
+	cells      []mpmcCell[T]
+	mask       uint64
+}
+
+// NewMPMCQueue creates a queue holding at least capacity elements,
+// rounded up to a power of two.
+func NewMPMCQueue[T any](capacity int) *MPMCQueue[T] {
+	if capacity <= 0 {
+		panic("mpmc queue capacity must be positive")
+	}
+
+	capacity = nextPowerOfTwo(capacity)
+
+	queue := MPMCQueue[T]{
+		cells: make([]mpmcCell[T], capacity),
+		mask:  uint64(capacity - 1),
+	}
+
+	for i := range queue.cells {
+		queue.cells[i].sequence.Store(uint64(i))
+	}
+
+	return &queue
+}
+
+func nextPowerOfTwo(n int) int {
+	if n <= 1 {
+		return 1
+	}
+
+	return 1 << bits.Len(uint(n-1))
+}
+
+func (q *MPMCQueue[T]) Enqueue(value T) {
+	if !q.TryEnqueue(value) {
+		panic(fmt.Errorf("trying to enqueue to full queue: %w", ErrFull))
+	}
+}
+
+func (q *MPMCQueue[T]) TryEnqueue(value T) bool {
+	pos := q.enqueuePos.Load()
+
+	for {
+		cell := &q.cells[pos&q.mask]
+		diff := int64(cell.sequence.Load() - pos)
+
+		switch {
+		case diff == 0:
+			if q.enqueuePos.CompareAndSwap(pos, pos+1) {
+				cell.value = value
+				cell.sequence.Store(pos + 1)
+				return true
+			}
+			pos = q.enqueuePos.Load()
+		case diff < 0:
+			// the cell still holds a value from the previous lap
+			return false
+		default:
+			// another producer claimed this position first
+			pos = q.enqueuePos.Load()
+		}
+	}
+}
+
+func (q *MPMCQueue[T]) Dequeue() T {
+	value, ok := q.TryDequeue()
+	if !ok {
+		panic(fmt.Errorf("trying to dequeue from empty queue: %w", ErrEmpty))
+	}
+
+	return value
+}
+
+func (q *MPMCQueue[T]) TryDequeue() (T, bool) {
+	var value T
+
+	pos := q.dequeuePos.Load()
+
+	for {
+		cell := &q.cells[pos&q.mask]
+		diff := int64(cell.sequence.Load() - (pos + 1))
+
+		switch {
+		case diff == 0:
+			if q.dequeuePos.CompareAndSwap(pos, pos+1) {
+				var zero T
+
+				value = cell.value
+				cell.value = zero
+				cell.sequence.Store(pos + q.mask + 1)
+				return value, true
+			}
+			pos = q.dequeuePos.Load()
+		case diff < 0:
+			// no producer has published this position yet
+			return value, false
+		default:
+			// another consumer claimed this position first
+			pos = q.dequeuePos.Load()
+		}
+	}
+}
+
+// Len returns the number of elements in the queue.
+// Under concurrent use it is only a snapshot and may be stale immediately.
+func (q *MPMCQueue[T]) Len() int {
+	dequeuePos := q.dequeuePos.Load()
+	enqueuePos := q.enqueuePos.Load()
+
+	if enqueuePos <= dequeuePos {
+		return 0
+	}
+
+	return min(int(enqueuePos-dequeuePos), len(q.cells))
+}
+
+func (q *MPMCQueue[T]) Cap() int {
+	return len(q.cells)
+}
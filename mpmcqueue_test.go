@@ -0,0 +1,215 @@
+package collections
+
+import (
+	"errors"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMPMCQueueCapacity(t *testing.T) {
+	type TestCase struct {
+		Name     string
+		Capacity int
+		Expected int
+	}
+
+	testCases := []TestCase{
+		{
+			Name:     "one",
+			Capacity: 1,
+			Expected: 1,
+		},
+		{
+			Name:     "power of two",
+			Capacity: 8,
+			Expected: 8,
+		},
+		{
+			Name:     "rounded up",
+			Capacity: 9,
+			Expected: 16,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			queue := NewMPMCQueue[int](testCase.Capacity)
+
+			assert.Equal(t, testCase.Expected, queue.Cap())
+		})
+	}
+
+	t.Run("non-positive capacity", func(t *testing.T) {
+		require.Panics(t, func() {
+			NewMPMCQueue[int](0)
+		})
+	})
+}
+
+func TestMPMCQueueSequential(t *testing.T) {
+	queue := NewMPMCQueue[int](4)
+
+	_, ok := queue.TryDequeue()
+	assert.False(t, ok)
+
+	// run several laps around the ring to exercise sequence wrap-around
+	for lap := 0; lap < 3; lap++ {
+		for i := 0; i < 4; i++ {
+			require.True(t, queue.TryEnqueue(lap*4+i))
+		}
+		require.False(t, queue.TryEnqueue(-1))
+		require.Equal(t, 4, queue.Len())
+
+		for i := 0; i < 4; i++ {
+			require.Equal(t, lap*4+i, queue.Dequeue())
+		}
+		require.Equal(t, 0, queue.Len())
+	}
+
+	func() {
+		defer func() {
+			err, ok := recover().(error)
+			require.True(t, ok)
+			assert.True(t, errors.Is(err, ErrEmpty))
+		}()
+
+		queue.Dequeue()
+	}()
+
+	func() {
+		defer func() {
+			err, ok := recover().(error)
+			require.True(t, ok)
+			assert.True(t, errors.Is(err, ErrFull))
+		}()
+
+		for {
+			queue.Enqueue(0)
+		}
+	}()
+}
+
+func TestMPMCQueueConcurrent(t *testing.T) {
+	const producers = 8
+	const consumers = 8
+	const perProducer = 20000
+
+	type item struct {
+		producer int
+		seq      int
+	}
+
+	queue := NewMPMCQueue[item](64)
+
+	var producersWg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		producersWg.Add(1)
+		go func() {
+			defer producersWg.Done()
+			for i := 0; i < perProducer; i++ {
+				for !queue.TryEnqueue(item{producer: p, seq: i}) {
+					runtime.Gosched()
+				}
+			}
+		}()
+	}
+
+	var consumed sync.WaitGroup
+	var left atomic.Int64
+	left.Store(producers * perProducer)
+	counts := make([][]int, consumers)
+
+	for c := 0; c < consumers; c++ {
+		consumed.Add(1)
+		go func() {
+			defer consumed.Done()
+
+			seen := make([]int, producers*perProducer)
+			last := make([]int, producers)
+			for i := range last {
+				last[i] = -1
+			}
+
+			for left.Load() > 0 {
+
+				value, ok := queue.TryDequeue()
+				if !ok {
+					runtime.Gosched()
+					continue
+				}
+
+				// each consumer must observe every producer's items in order
+				assert.Greater(t, value.seq, last[value.producer])
+				last[value.producer] = value.seq
+				seen[value.producer*perProducer+value.seq]++
+				left.Add(-1)
+			}
+
+			counts[c] = seen
+		}()
+	}
+
+	producersWg.Wait()
+	consumed.Wait()
+
+	for i := 0; i < producers*perProducer; i++ {
+		total := 0
+		for c := 0; c < consumers; c++ {
+			total += counts[c][i]
+		}
+		require.Equal(t, 1, total, "item %d", i)
+	}
+
+	assert.Equal(t, 0, queue.Len())
+}
+
+func BenchmarkMPMCQueue(b *testing.B) {
+	queue := NewMPMCQueue[int](1024)
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			for !queue.TryEnqueue(1) {
+				runtime.Gosched()
+			}
+			for {
+				if _, ok := queue.TryDequeue(); ok {
+					break
+				}
+				runtime.Gosched()
+			}
+		}
+	})
+}
+
+func BenchmarkMPMCChannel(b *testing.B) {
+	channel := make(chan int, 1024)
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			channel <- 1
+			<-channel
+		}
+	})
+}
+
+func BenchmarkMPMCMutexQueue(b *testing.B) {
+	var mu sync.Mutex
+	queue := NewQueueWithOptions[int](QueueOptions{InitialCapacity: 1024})
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			mu.Lock()
+			queue.Enqueue(1)
+			mu.Unlock()
+
+			mu.Lock()
+			queue.Dequeue()
+			mu.Unlock()
+		}
+	})
+}
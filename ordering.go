@@ -0,0 +1,57 @@
+package collections
+
+import "fmt"
+
+// Ordering is a comparator with an identity. Go cannot compare functions,
+// so heaps are only known to order elements the same way when they were
+// created from the same Ordering, which Heap.Merge, MergeHeaps and
+// PairingHeap.Meld require. Constructors taking a comparator create
+// a new Ordering every time.
+type Ordering[T any] struct {
+	compare func(T, T) int
+}
+
+func NewOrdering[T any](compare func(T, T) int) *Ordering[T] {
+	return &Ordering[T]{
+		compare: compare,
+	}
+}
+
+func (o *Ordering[T]) Compare(a, b T) int {
+	return o.compare(a, b)
+}
+
+// NewHeap creates a binary heap ordered by o.
+func (o *Ordering[T]) NewHeap() *Heap[T] {
+	return o.NewDaryHeap(2)
+}
+
+// NewDaryHeap creates a heap ordered by o in which every node has up to d children.
+func (o *Ordering[T]) NewDaryHeap(d int) *Heap[T] {
+	if d < 2 {
+		panic("heap arity must be at least 2")
+	}
+
+	heap := Heap[T]{
+		slice:    make([]T, 0),
+		ordering: o,
+		compare:  o.compare,
+		arity:    d,
+	}
+
+	return &heap
+}
+
+// NewPairingHeap creates a pairing heap ordered by o.
+func (o *Ordering[T]) NewPairingHeap() *PairingHeap[T] {
+	return &PairingHeap[T]{
+		ordering: o,
+		compare:  o.compare,
+	}
+}
+
+func mustShareOrdering[T any](a, b *Ordering[T]) {
+	if a != b {
+		panic(fmt.Errorf("trying to merge heaps: %w", ErrIncompatibleComparators))
+	}
+}
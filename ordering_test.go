@@ -0,0 +1,32 @@
+package collections
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrdering(t *testing.T) {
+	ordering := NewOrdering(Reverse(intCompare))
+
+	binary := ordering.NewHeap()
+	dary := ordering.NewDaryHeap(3)
+	pairing := ordering.NewPairingHeap()
+	for _, value := range []int{4, 1, 3} {
+		binary.Push(value)
+		dary.Push(value + 1)
+		pairing.Push(value)
+	}
+
+	assert.Equal(t, 1, ordering.Compare(1, 2))
+	assert.Same(t, ordering, binary.Ordering())
+	assert.Same(t, ordering, pairing.Ordering())
+	assert.Equal(t, []int{5, 4, 4, 3, 2, 1}, slices.Collect(MergeHeaps(binary, dary).Drain()))
+
+	pairing.Meld(pairing.Ordering().NewPairingHeap())
+	assert.Equal(t, []int{4, 3, 1}, slices.Collect(pairing.Drain()))
+	assert.Panics(t, func() {
+		ordering.NewDaryHeap(1)
+	})
+}
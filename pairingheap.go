@@ -0,0 +1,187 @@
+package collections
+
+import (
+	"fmt"
+	"iter"
+)
+
+type pairingNode[T any] struct {
+	value   T
+	child   *pairingNode[T]
+	sibling *pairingNode[T]
+}
+
+// PairingHeap is a mergeable heap: Push, Peek and Meld are O(1)
+// and Pop is amortized O(log n).
+type PairingHeap[T any] struct {
+	root     *pairingNode[T]
+	len      int
+	ordering *Ordering[T]
+	compare  func(T, T) int
+}
+
+func NewPairingHeap[T any](compare func(T, T) int) *PairingHeap[T] {
+	return NewOrdering(compare).NewPairingHeap()
+}
+
+func (h *PairingHeap[T]) Push(value T) {
+	h.root = h.link(h.root, &pairingNode[T]{value: value})
+	h.len++
+}
+
+func (h *PairingHeap[T]) Pop() T {
+	value, ok := h.TryPop()
+	if !ok {
+		panic(fmt.Errorf("pop from empty heap: %w", ErrEmpty))
+	}
+
+	return value
+}
+
+func (h *PairingHeap[T]) TryPop() (T, bool) {
+	var value T
+
+	if h.root == nil {
+		return value, false
+	}
+
+	value = h.root.value
+	h.root = h.mergePairs(h.root.child)
+	h.len--
+
+	return value, true
+}
+
+func (h *PairingHeap[T]) Peek() T {
+	value, ok := h.TryPeek()
+	if !ok {
+		panic(fmt.Errorf("peek from empty heap: %w", ErrEmpty))
+	}
+
+	return value
+}
+
+func (h *PairingHeap[T]) TryPeek() (T, bool) {
+	var value T
+
+	if h.root == nil {
+		return value, false
+	}
+
+	return h.root.value, true
+}
+
+func (h *PairingHeap[T]) Len() int {
+	return h.len
+}
+
+// Ordering returns the Ordering of h, from which heaps that can be melded with h are created.
+func (h *PairingHeap[T]) Ordering() *Ordering[T] {
+	return h.ordering
+}
+
+// Meld moves all elements of other into h in O(1), leaving other empty.
+// Both heaps must share an Ordering, for example by creating other
+// from h.Ordering(), otherwise Meld panics with ErrIncompatibleComparators.
+func (h *PairingHeap[T]) Meld(other *PairingHeap[T]) {
+	if h == other {
+		panic("trying to meld heap with itself")
+	}
+
+	mustShareOrdering(h.ordering, other.ordering)
+
+	h.root = h.link(h.root, other.root)
+	h.len += other.len
+
+	other.root = nil
+	other.len = 0
+}
+
+// link makes the root with lower priority the first child of the other one.
+func (h *PairingHeap[T]) link(a, b *pairingNode[T]) *pairingNode[T] {
+	if a == nil {
+		return b
+	}
+
+	if b == nil {
+		return a
+	}
+
+	if h.compare(b.value, a.value) < 0 {
+		a, b = b, a
+	}
+
+	b.sibling = a.child
+	a.child = b
+
+	return a
+}
+
+// mergePairs links the sibling list starting at first into a single tree
+// with the standard two passes, reusing sibling pointers instead of a stack.
+func (h *PairingHeap[T]) mergePairs(first *pairingNode[T]) *pairingNode[T] {
+	// left to right: link adjacent pairs, collecting the results in reverse order
+	var pairs *pairingNode[T]
+	for first != nil {
+		a, b := first, first.sibling
+		if b == nil {
+			first = nil
+		} else {
+			first = b.sibling
+			b.sibling = nil
+		}
+		a.sibling = nil
+
+		pair := h.link(a, b)
+		pair.sibling = pairs
+		pairs = pair
+	}
+
+	// right to left: link every pair into the accumulated tree
+	var root *pairingNode[T]
+	for pairs != nil {
+		next := pairs.sibling
+		pairs.sibling = nil
+		root = h.link(root, pairs)
+		pairs = next
+	}
+
+	return root
+}
+
+// All yields the elements in unspecified order.
+// The heap must not be modified during iteration.
+func (h *PairingHeap[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if h.root == nil {
+			return
+		}
+
+		stack := []*pairingNode[T]{h.root}
+		for len(stack) > 0 {
+			node := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+
+			if !yield(node.value) {
+				return
+			}
+
+			for child := node.child; child != nil; child = child.sibling {
+				stack = append(stack, child)
+			}
+		}
+	}
+}
+
+// Drain pops and yields elements in priority order until the heap is empty.
+// Elements pushed during iteration are drained as well.
+func (h *PairingHeap[T]) Drain() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for {
+			value, ok := h.TryPop()
+			if !ok || !yield(value) {
+				return
+			}
+		}
+	}
+}
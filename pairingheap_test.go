@@ -0,0 +1,110 @@
+package collections
+
+import (
+	"errors"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPairingHeap(t *testing.T) {
+	type TestCase struct {
+		Name  string
+		Input []int
+	}
+
+	var largeSlice []int
+	for i := 0; i < 10000; i++ {
+		largeSlice = append(largeSlice, rand.Intn(10000))
+	}
+
+	testCases := []TestCase{
+		{
+			Name:  "single element",
+			Input: []int{1},
+		},
+		{
+			Name:  "three elements",
+			Input: []int{2, 1, 3},
+		},
+		{
+			Name:  "large slice",
+			Input: largeSlice,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			heap := NewPairingHeap(intCompare)
+			for _, num := range testCase.Input {
+				heap.Push(num)
+			}
+
+			expected := slices.Sorted(slices.Values(testCase.Input))
+
+			assert.Equal(t, len(testCase.Input), heap.Len())
+			assert.Equal(t, expected, slices.Sorted(heap.All()))
+			assert.Equal(t, expected[0], heap.Peek())
+			assert.Equal(t, expected, slices.Collect(heap.Drain()))
+		})
+	}
+
+	t.Run("empty heap", func(t *testing.T) {
+		heap := NewPairingHeap(intCompare)
+
+		_, okPop := heap.TryPop()
+		_, okPeek := heap.TryPeek()
+
+		assert.False(t, okPop)
+		assert.False(t, okPeek)
+
+		defer func() {
+			err, ok := recover().(error)
+			require.True(t, ok)
+			assert.True(t, errors.Is(err, ErrEmpty))
+		}()
+
+		heap.Pop()
+	})
+}
+
+func TestPairingHeapMeld(t *testing.T) {
+	rnd := rand.New(rand.NewSource(3))
+	heap := NewPairingHeap(intCompare)
+	reference := make([]int, 0)
+
+	for round := 0; round < 200; round++ {
+		other := heap.Ordering().NewPairingHeap()
+		for i := rnd.Intn(50); i > 0; i-- {
+			value := rnd.Intn(1000)
+			other.Push(value)
+			reference = append(reference, value)
+		}
+
+		heap.Meld(other)
+		require.Equal(t, 0, other.Len())
+
+		slices.Sort(reference)
+		for i := rnd.Intn(20); i > 0 && len(reference) > 0; i-- {
+			require.Equal(t, reference[0], heap.Pop())
+			reference = reference[1:]
+		}
+
+		require.Equal(t, len(reference), heap.Len())
+	}
+
+	assert.Equal(t, reference, slices.Collect(heap.Drain()))
+
+	t.Run("different orderings", func(t *testing.T) {
+		defer func() {
+			err, ok := recover().(error)
+			require.True(t, ok)
+			assert.True(t, errors.Is(err, ErrIncompatibleComparators))
+		}()
+
+		NewPairingHeap(intCompare).Meld(NewPairingHeap(intCompare))
+	})
+}
@@ -1,36 +1,96 @@
 package collections
 
+import (
+	"fmt"
+	"iter"
+)
+
 type Queue[T any] struct {
 	buf   []T
 	read  int
 	write int
 	len   int
+
+	growth      func(int) int
+	minCap      int
+	maxCap      int
+	shrinkBelow float64
+}
+
+type QueueOptions struct {
+	// InitialCapacity is allocated up front and is never shrunk below.
+	InitialCapacity int
+	// MaxCapacity bounds the buffer, zero means unbounded.
+	MaxCapacity int
+	// Growth returns the next capacity for a full buffer of the given capacity.
+	// Nil keeps the default policy: double below 1024 elements, then grow by 1.25x.
+	Growth func(capacity int) int
+	// ShrinkThreshold halves the buffer whenever occupancy drops below
+	// this fraction of its capacity. Zero disables automatic shrinking.
+	ShrinkThreshold float64
 }
 
 func NewQueue[T any]() *Queue[T] {
 	return &Queue[T]{}
 }
 
+func NewQueueWithOptions[T any](options QueueOptions) *Queue[T] {
+	var queue Queue[T]
+	queue.applyOptions(options)
+
+	return &queue
+}
+
+func (q *Queue[T]) applyOptions(options QueueOptions) {
+	if options.InitialCapacity < 0 || options.MaxCapacity < 0 {
+		panic("negative queue capacity")
+	}
+
+	if options.MaxCapacity > 0 && options.InitialCapacity > options.MaxCapacity {
+		panic("initial capacity exceeds max capacity")
+	}
+
+	// a threshold of one half or more would shrink a buffer that the next enqueue grows back
+	if options.ShrinkThreshold < 0 || options.ShrinkThreshold >= 0.5 {
+		panic("shrink threshold must be in range [0, 0.5)")
+	}
+
+	q.growth = options.Growth
+	q.minCap = options.InitialCapacity
+	q.maxCap = options.MaxCapacity
+	q.shrinkBelow = options.ShrinkThreshold
+
+	q.resize(options.InitialCapacity)
+}
+
 func (q *Queue[T]) resize(targetCapacity int) {
 	if len(q.buf) >= targetCapacity {
 		return
 	}
 
-	newBuf := make([]T, targetCapacity)
-	newBufP := 0
+	q.reallocate(targetCapacity)
+}
 
-	for i := q.read; i < q.len; i++ {
-		newBuf[newBufP] = q.buf[i]
-		newBufP++
+func (q *Queue[T]) reallocate(capacity int) {
+	if len(q.buf) == capacity {
+		return
 	}
-	q.read = 0
 
-	for i := 0; i < q.write; i++ {
-		newBuf[newBufP] = q.buf[i]
-		newBufP++
+	newBuf := make([]T, capacity)
+
+	// contents are either one contiguous run or wrap around the end of buf
+	if q.len > 0 && q.read < q.write {
+		copy(newBuf, q.buf[q.read:q.write])
+	} else if q.len > 0 {
+		n := copy(newBuf, q.buf[q.read:])
+		copy(newBuf[n:], q.buf[:q.write])
 	}
 
-	q.write = len(q.buf)
+	q.read = 0
+	q.write = q.len
+	if q.write == capacity {
+		q.write = 0
+	}
 
 	q.buf = newBuf
 }
@@ -39,6 +99,26 @@ func (q *Queue[T]) desiredCap() int {
 	var oldCap = len(q.buf)
 	var newCap int
 
+	if q.growth != nil {
+		newCap = q.growth(oldCap)
+	} else {
+		newCap = defaultGrowth(oldCap)
+	}
+
+	if newCap <= oldCap {
+		newCap = oldCap + 1
+	}
+
+	if q.maxCap > 0 && newCap > q.maxCap {
+		newCap = q.maxCap
+	}
+
+	return newCap
+}
+
+func defaultGrowth(oldCap int) int {
+	var newCap int
+
 	switch {
 	case oldCap == 0:
 		newCap = 1
@@ -51,40 +131,158 @@ func (q *Queue[T]) desiredCap() int {
 	return newCap
 }
 
+// makeRoom grows a full buffer and reports false when it is already at max capacity.
+func (q *Queue[T]) makeRoom() bool {
+	if q.len < len(q.buf) {
+		return true
+	}
+
+	if q.maxCap > 0 && len(q.buf) >= q.maxCap {
+		return false
+	}
+
+	q.resize(q.desiredCap())
+
+	return true
+}
+
+func (q *Queue[T]) shrinkIfSparse() {
+	if q.shrinkBelow == 0 || len(q.buf) <= q.minCap {
+		return
+	}
+
+	if float64(q.len) < q.shrinkBelow*float64(len(q.buf)) {
+		q.reallocate(max(len(q.buf)/2, q.minCap, q.len))
+	}
+}
+
 func (q *Queue[T]) Enqueue(value T) {
-	// resize is necessary when:
-	// 1. len(buf) = 0
-	// 2. q.write == q.read && q.len > 0
+	if !q.TryEnqueue(value) {
+		panic(fmt.Errorf("trying to enqueue to queue at max capacity: %w", ErrFull))
+	}
+}
 
-	if (len(q.buf) == 0) || (q.write == q.read && q.len > 0) {
-		q.resize(q.desiredCap())
+func (q *Queue[T]) TryEnqueue(value T) bool {
+	if !q.makeRoom() {
+		return false
 	}
 
 	q.buf[q.write] = value
 	q.write = (q.write + 1) % len(q.buf)
 
 	q.len++
+
+	return true
+}
+
+// EnqueueSlice enqueues all values in order, growing the buffer at most once.
+// It panics without enqueuing anything if the values do not fit into max capacity.
+func (q *Queue[T]) EnqueueSlice(values []T) {
+	if len(values) == 0 {
+		return
+	}
+
+	required := q.len + len(values)
+	if q.maxCap > 0 && required > q.maxCap {
+		panic(fmt.Errorf("trying to enqueue %d elements to queue at max capacity: %w", len(values), ErrFull))
+	}
+
+	if required > len(q.buf) {
+		q.resize(max(q.desiredCap(), required))
+	}
+
+	n := copy(q.buf[q.write:], values)
+	copy(q.buf, values[n:])
+	q.write = (q.write + len(values)) % len(q.buf)
+
+	q.len += len(values)
+}
+
+func (q *Queue[T]) EnqueueAll(values iter.Seq[T]) {
+	for value := range values {
+		q.Enqueue(value)
+	}
 }
 
 func (q *Queue[T]) Dequeue() T {
+	val, ok := q.TryDequeue()
+	if !ok {
+		panic(fmt.Errorf("trying to dequeue from empty queue: %w", ErrEmpty))
+	}
+
+	return val
+}
+
+func (q *Queue[T]) TryDequeue() (T, bool) {
+	var val T
+
 	if q.len == 0 {
-		panic("trying to dequeue from empty queue")
+		return val, false
 	}
 
-	val := q.buf[q.read]
+	var zero T
+
+	val = q.buf[q.read]
+	q.buf[q.read] = zero
 	q.read = (q.read + 1) % len(q.buf)
 
 	q.len--
+	q.shrinkIfSparse()
 
-	return val
+	return val, true
+}
+
+// DequeueN dequeues up to n elements and returns them in FIFO order.
+func (q *Queue[T]) DequeueN(n int) []T {
+	if n < 0 {
+		panic("trying to dequeue negative number of elements")
+	}
+
+	values := make([]T, min(n, q.len))
+	q.DrainTo(values)
+
+	return values
+}
+
+// DrainTo dequeues up to len(dst) elements into dst and returns their number.
+func (q *Queue[T]) DrainTo(dst []T) int {
+	n := min(len(dst), q.len)
+	if n == 0 {
+		return 0
+	}
+
+	end := min(q.read+n, len(q.buf))
+	copied := copy(dst, q.buf[q.read:end])
+	clear(q.buf[q.read:end])
+
+	copy(dst[copied:n], q.buf[:n-copied])
+	clear(q.buf[:n-copied])
+
+	q.read = (q.read + n) % len(q.buf)
+
+	q.len -= n
+	q.shrinkIfSparse()
+
+	return n
 }
 
 func (q *Queue[T]) Peek() T {
+	val, ok := q.TryPeek()
+	if !ok {
+		panic(fmt.Errorf("trying to peek from empty queue: %w", ErrEmpty))
+	}
+
+	return val
+}
+
+func (q *Queue[T]) TryPeek() (T, bool) {
+	var val T
+
 	if q.len == 0 {
-		panic("trying to peek from empty queue")
+		return val, false
 	}
 
-	return q.buf[q.read]
+	return q.buf[q.read], true
 }
 
 func (q *Queue[T]) Len() int {
@@ -100,5 +298,72 @@ func (q *Queue[T]) Grow(targetCapacity int) {
 		panic("trying to grow from negative capacity")
 	}
 
+	if q.maxCap > 0 && targetCapacity > q.maxCap {
+		panic("trying to grow beyond max capacity")
+	}
+
 	q.resize(targetCapacity)
 }
+
+// Shrink releases unused capacity down to the larger of Len()
+// and the initial capacity the queue was created with.
+func (q *Queue[T]) Shrink() {
+	q.reallocate(max(q.len, q.minCap))
+}
+
+// TrimToSize releases all unused capacity so that Cap() equals Len().
+func (q *Queue[T]) TrimToSize() {
+	q.reallocate(q.len)
+}
+
+// ToSlice returns a copy of the elements in FIFO order.
+func (q *Queue[T]) ToSlice() []T {
+	values := make([]T, q.len)
+	if q.len == 0 {
+		return values
+	}
+
+	end := min(q.read+q.len, len(q.buf))
+	copied := copy(values, q.buf[q.read:end])
+	copy(values[copied:], q.buf[:q.len-copied])
+
+	return values
+}
+
+// All yields the elements from front to back without removing them.
+// The queue is read live: elements enqueued during iteration are yielded too,
+// while dequeuing during iteration shifts the remaining elements forward.
+func (q *Queue[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < q.len; i++ {
+			if !yield(q.buf[(q.read+i)%len(q.buf)]) {
+				return
+			}
+		}
+	}
+}
+
+// Indexed is like All but also yields the position of each element.
+func (q *Queue[T]) Indexed() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := 0; i < q.len; i++ {
+			if !yield(i, q.buf[(q.read+i)%len(q.buf)]) {
+				return
+			}
+		}
+	}
+}
+
+// Drain dequeues and yields elements until the queue is empty.
+// Elements enqueued during iteration are drained as well.
+// Breaking out of the loop leaves the remaining elements in the queue.
+func (q *Queue[T]) Drain() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for {
+			value, ok := q.TryDequeue()
+			if !ok || !yield(value) {
+				return
+			}
+		}
+	}
+}
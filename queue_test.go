@@ -1,6 +1,9 @@
 package collections
 
 import (
+	"errors"
+	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -178,3 +181,573 @@ func TestGrow(t *testing.T) {
 		assert.Equal(t, expectedQueueCap, queueCapAfterEnqueue)
 	})
 }
+
+func TestTryDequeue(t *testing.T) {
+	t.Run("empty queue", func(t *testing.T) {
+		queue := NewQueue[int]()
+
+		value, ok := queue.TryDequeue()
+
+		assert.False(t, ok)
+		assert.Equal(t, 0, value)
+	})
+
+	t.Run("drains in order", func(t *testing.T) {
+		input := []int{5, 10, 11}
+		queue := NewQueue[int]()
+		for _, element := range input {
+			queue.Enqueue(element)
+		}
+
+		var dequeued []int
+		for {
+			value, ok := queue.TryDequeue()
+			if !ok {
+				break
+			}
+
+			dequeued = append(dequeued, value)
+		}
+
+		assert.Equal(t, input, dequeued)
+		assert.Equal(t, 0, queue.Len())
+	})
+}
+
+func TestTryPeek(t *testing.T) {
+	t.Run("empty queue", func(t *testing.T) {
+		queue := NewQueue[int]()
+
+		_, ok := queue.TryPeek()
+
+		assert.False(t, ok)
+	})
+
+	t.Run("non-empty queue", func(t *testing.T) {
+		queue := NewQueue[int]()
+		queue.Enqueue(5)
+		queue.Enqueue(10)
+
+		value, ok := queue.TryPeek()
+
+		assert.True(t, ok)
+		assert.Equal(t, 5, value)
+		assert.Equal(t, 2, queue.Len())
+	})
+}
+
+func TestQueuePanicErrors(t *testing.T) {
+	testCases := map[string]func(queue *Queue[int]){
+		"dequeue": func(queue *Queue[int]) { queue.Dequeue() },
+		"peek":    func(queue *Queue[int]) { queue.Peek() },
+	}
+
+	for name, call := range testCases {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				err, ok := recover().(error)
+				require.True(t, ok)
+				assert.True(t, errors.Is(err, ErrEmpty))
+			}()
+
+			call(NewQueue[int]())
+		})
+	}
+}
+
+func TestQueueIterators(t *testing.T) {
+	type TestCase[T any] struct {
+		Name  string
+		Input []T
+	}
+
+	testCases := []TestCase[int]{
+		{
+			Name:  "empty queue",
+			Input: []int{},
+		},
+		{
+			Name:  "single element",
+			Input: []int{5},
+		},
+		{
+			Name:  "many elements",
+			Input: []int{5, 10, 11, 12, 13, 14, 15},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(fmt.Sprintf("all %s", testCase.Name), func(t *testing.T) {
+			queue := NewQueue[int]()
+			for _, element := range testCase.Input {
+				queue.Enqueue(element)
+			}
+
+			actual := make([]int, 0)
+			for value := range queue.All() {
+				actual = append(actual, value)
+			}
+
+			assert.Equal(t, testCase.Input, actual)
+			assert.Equal(t, len(testCase.Input), queue.Len())
+		})
+
+		t.Run(fmt.Sprintf("indexed %s", testCase.Name), func(t *testing.T) {
+			queue := NewQueue[int]()
+			for _, element := range testCase.Input {
+				queue.Enqueue(element)
+			}
+
+			for i, value := range queue.Indexed() {
+				assert.Equal(t, testCase.Input[i], value)
+			}
+		})
+
+		t.Run(fmt.Sprintf("drain %s", testCase.Name), func(t *testing.T) {
+			queue := NewQueue[int]()
+			for _, element := range testCase.Input {
+				queue.Enqueue(element)
+			}
+
+			actual := make([]int, 0)
+			for value := range queue.Drain() {
+				actual = append(actual, value)
+			}
+
+			assert.Equal(t, testCase.Input, actual)
+			assert.Equal(t, 0, queue.Len())
+		})
+	}
+
+	t.Run("all after wrap around", func(t *testing.T) {
+		queue := NewQueue[int]()
+		queue.Grow(4)
+		for _, element := range []int{1, 2, 3, 4} {
+			queue.Enqueue(element)
+		}
+		queue.Dequeue()
+		queue.Dequeue()
+		queue.Enqueue(5)
+
+		assert.Equal(t, []int{3, 4, 5}, slices.Collect(queue.All()))
+	})
+
+	t.Run("enqueue during all", func(t *testing.T) {
+		queue := NewQueue[int]()
+		queue.Enqueue(1)
+
+		actual := make([]int, 0)
+		for value := range queue.All() {
+			actual = append(actual, value)
+			if value < 3 {
+				queue.Enqueue(value + 1)
+			}
+		}
+
+		assert.Equal(t, []int{1, 2, 3}, actual)
+	})
+
+	t.Run("enqueue during drain", func(t *testing.T) {
+		queue := NewQueue[int]()
+		queue.Enqueue(1)
+
+		actual := make([]int, 0)
+		for value := range queue.Drain() {
+			actual = append(actual, value)
+			if value < 3 {
+				queue.Enqueue(value + 1)
+			}
+		}
+
+		assert.Equal(t, []int{1, 2, 3}, actual)
+		assert.Equal(t, 0, queue.Len())
+	})
+
+	t.Run("break during drain", func(t *testing.T) {
+		queue := NewQueue[int]()
+		for _, element := range []int{1, 2, 3} {
+			queue.Enqueue(element)
+		}
+
+		for range queue.Drain() {
+			break
+		}
+
+		assert.Equal(t, []int{2, 3}, slices.Collect(queue.All()))
+	})
+}
+
+func TestGrowPreservesOrder(t *testing.T) {
+	type TestCase struct {
+		Name     string
+		Enqueue  []int
+		Dequeues int
+		Append   []int
+	}
+
+	testCases := []TestCase{
+		{
+			Name:     "contiguous contents",
+			Enqueue:  []int{1, 2, 3, 4},
+			Dequeues: 1,
+			Append:   []int{},
+		},
+		{
+			Name:     "wrapped contents",
+			Enqueue:  []int{1, 2, 3, 4},
+			Dequeues: 3,
+			Append:   []int{5, 6},
+		},
+		{
+			Name:     "full wrapped buffer",
+			Enqueue:  []int{1, 2, 3, 4},
+			Dequeues: 2,
+			Append:   []int{5, 6},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			queue := NewQueue[int]()
+			queue.Grow(len(testCase.Enqueue))
+
+			for _, element := range testCase.Enqueue {
+				queue.Enqueue(element)
+			}
+			for i := 0; i < testCase.Dequeues; i++ {
+				queue.Dequeue()
+			}
+			for _, element := range testCase.Append {
+				queue.Enqueue(element)
+			}
+
+			expected := append(slices.Clone(testCase.Enqueue[testCase.Dequeues:]), testCase.Append...)
+
+			queue.Grow(16)
+			queue.Enqueue(100)
+			expected = append(expected, 100)
+
+			assert.Equal(t, 16, queue.Cap())
+			assert.Equal(t, expected, slices.Collect(queue.Drain()))
+		})
+	}
+}
+
+func TestShrink(t *testing.T) {
+	t.Run("shrink keeps contents", func(t *testing.T) {
+		queue := NewQueue[int]()
+		for i := 0; i < 100; i++ {
+			queue.Enqueue(i)
+		}
+		for i := 0; i < 95; i++ {
+			queue.Dequeue()
+		}
+
+		queue.Shrink()
+
+		assert.Equal(t, 5, queue.Cap())
+		assert.Equal(t, []int{95, 96, 97, 98, 99}, slices.Collect(queue.All()))
+	})
+
+	t.Run("shrink respects initial capacity", func(t *testing.T) {
+		queue := NewQueueWithOptions[int](QueueOptions{InitialCapacity: 16})
+		for i := 0; i < 100; i++ {
+			queue.Enqueue(i)
+		}
+		for i := 0; i < 98; i++ {
+			queue.Dequeue()
+		}
+
+		queue.Shrink()
+		capAfterShrink := queue.Cap()
+
+		queue.TrimToSize()
+		capAfterTrim := queue.Cap()
+
+		assert.Equal(t, 16, capAfterShrink)
+		assert.Equal(t, 2, capAfterTrim)
+		assert.Equal(t, []int{98, 99}, slices.Collect(queue.Drain()))
+	})
+
+	t.Run("trim empty queue", func(t *testing.T) {
+		queue := NewQueue[int]()
+		queue.Grow(10)
+
+		queue.TrimToSize()
+		capAfterTrim := queue.Cap()
+		queue.Enqueue(1)
+
+		assert.Equal(t, 0, capAfterTrim)
+		assert.Equal(t, 1, queue.Dequeue())
+	})
+
+	t.Run("automatic shrink", func(t *testing.T) {
+		queue := NewQueueWithOptions[int](QueueOptions{
+			InitialCapacity: 8,
+			ShrinkThreshold: 0.25,
+		})
+		for i := 0; i < 1000; i++ {
+			queue.Enqueue(i)
+		}
+		peakCap := queue.Cap()
+
+		for i := 0; i < 1000; i++ {
+			require.Equal(t, i, queue.Dequeue())
+			require.GreaterOrEqual(t, queue.Cap(), queue.Len())
+		}
+
+		assert.Equal(t, 1024, peakCap)
+		assert.Equal(t, 8, queue.Cap())
+	})
+}
+
+func TestQueueOptions(t *testing.T) {
+	t.Run("initial capacity", func(t *testing.T) {
+		queue := NewQueueWithOptions[int](QueueOptions{InitialCapacity: 7})
+
+		assert.Equal(t, 7, queue.Cap())
+	})
+
+	t.Run("custom growth", func(t *testing.T) {
+		queue := NewQueueWithOptions[int](QueueOptions{
+			Growth: func(capacity int) int { return capacity + 3 },
+		})
+
+		capacities := make([]int, 0)
+		for i := 0; i < 9; i++ {
+			queue.Enqueue(i)
+			capacities = append(capacities, queue.Cap())
+		}
+
+		assert.Equal(t, []int{3, 3, 3, 6, 6, 6, 9, 9, 9}, capacities)
+	})
+
+	t.Run("non-increasing growth still grows", func(t *testing.T) {
+		queue := NewQueueWithOptions[int](QueueOptions{
+			Growth: func(capacity int) int { return capacity },
+		})
+
+		for i := 0; i < 3; i++ {
+			queue.Enqueue(i)
+		}
+
+		assert.Equal(t, 3, queue.Cap())
+	})
+
+	t.Run("max capacity", func(t *testing.T) {
+		queue := NewQueueWithOptions[int](QueueOptions{MaxCapacity: 5})
+
+		for i := 0; i < 5; i++ {
+			require.True(t, queue.TryEnqueue(i))
+		}
+		ok := queue.TryEnqueue(5)
+
+		assert.False(t, ok)
+		assert.Equal(t, 5, queue.Cap())
+		assert.Equal(t, []int{0, 1, 2, 3, 4}, slices.Collect(queue.All()))
+
+		defer func() {
+			err, ok := recover().(error)
+			require.True(t, ok)
+			assert.True(t, errors.Is(err, ErrFull))
+		}()
+		queue.Enqueue(5)
+	})
+
+	t.Run("grow beyond max capacity", func(t *testing.T) {
+		require.Panics(t, func() {
+			queue := NewQueueWithOptions[int](QueueOptions{MaxCapacity: 5})
+
+			queue.Grow(6)
+		})
+	})
+
+	t.Run("invalid options", func(t *testing.T) {
+		invalidOptions := []QueueOptions{
+			{InitialCapacity: -1},
+			{MaxCapacity: -1},
+			{InitialCapacity: 10, MaxCapacity: 5},
+			{ShrinkThreshold: 0.5},
+			{ShrinkThreshold: -0.1},
+		}
+
+		for _, options := range invalidOptions {
+			require.Panics(t, func() {
+				NewQueueWithOptions[int](options)
+			})
+		}
+	})
+}
+
+func TestEnqueueSlice(t *testing.T) {
+	type TestCase struct {
+		Name     string
+		Prefill  []int
+		Dequeues int
+		Input    []int
+	}
+
+	testCases := []TestCase{
+		{
+			Name:     "empty queue empty slice",
+			Prefill:  []int{},
+			Dequeues: 0,
+			Input:    []int{},
+		},
+		{
+			Name:     "empty queue",
+			Prefill:  []int{},
+			Dequeues: 0,
+			Input:    []int{1, 2, 3},
+		},
+		{
+			Name:     "fits without growing",
+			Prefill:  []int{1, 2, 3, 4, 5, 6, 7, 8},
+			Dequeues: 6,
+			Input:    []int{9, 10, 11},
+		},
+		{
+			Name:     "grows wrapped queue",
+			Prefill:  []int{1, 2, 3, 4, 5, 6, 7, 8},
+			Dequeues: 5,
+			Input:    []int{9, 10, 11, 12, 13, 14, 15, 16, 17, 18},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			queue := NewQueue[int]()
+			queue.Grow(len(testCase.Prefill))
+			for _, element := range testCase.Prefill {
+				queue.Enqueue(element)
+			}
+			for i := 0; i < testCase.Dequeues; i++ {
+				queue.Dequeue()
+			}
+			expected := append(slices.Clone(testCase.Prefill[testCase.Dequeues:]), testCase.Input...)
+
+			queue.EnqueueSlice(testCase.Input)
+
+			assert.Equal(t, expected, queue.ToSlice())
+			assert.Equal(t, len(expected), queue.Len())
+
+			queue.Enqueue(100)
+			assert.Equal(t, append(expected, 100), queue.ToSlice())
+		})
+	}
+
+	t.Run("max capacity", func(t *testing.T) {
+		queue := NewQueueWithOptions[int](QueueOptions{MaxCapacity: 4})
+		queue.Enqueue(1)
+
+		require.Panics(t, func() {
+			queue.EnqueueSlice([]int{2, 3, 4, 5})
+		})
+		assert.Equal(t, []int{1}, queue.ToSlice())
+
+		queue.EnqueueSlice([]int{2, 3, 4})
+		assert.Equal(t, []int{1, 2, 3, 4}, queue.ToSlice())
+	})
+
+	t.Run("enqueue all", func(t *testing.T) {
+		queue := NewQueue[int]()
+
+		queue.EnqueueAll(slices.Values([]int{1, 2, 3}))
+
+		assert.Equal(t, []int{1, 2, 3}, queue.ToSlice())
+	})
+}
+
+func TestDequeueN(t *testing.T) {
+	type TestCase struct {
+		Name     string
+		Prefill  []int
+		Dequeues int
+		Append   []int
+		N        int
+		Expected []int
+	}
+
+	testCases := []TestCase{
+		{
+			Name:     "empty queue",
+			Prefill:  []int{},
+			N:        3,
+			Expected: []int{},
+		},
+		{
+			Name:     "zero",
+			Prefill:  []int{1, 2},
+			N:        0,
+			Expected: []int{},
+		},
+		{
+			Name:     "fewer than available",
+			Prefill:  []int{1, 2, 3, 4},
+			N:        2,
+			Expected: []int{1, 2},
+		},
+		{
+			Name:     "more than available",
+			Prefill:  []int{1, 2, 3, 4},
+			N:        10,
+			Expected: []int{1, 2, 3, 4},
+		},
+		{
+			Name:     "wrapped contents",
+			Prefill:  []int{1, 2, 3, 4},
+			Dequeues: 3,
+			Append:   []int{5, 6},
+			N:        3,
+			Expected: []int{4, 5, 6},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			queue := NewQueue[int]()
+			queue.Grow(len(testCase.Prefill))
+			for _, element := range testCase.Prefill {
+				queue.Enqueue(element)
+			}
+			for i := 0; i < testCase.Dequeues; i++ {
+				queue.Dequeue()
+			}
+			for _, element := range testCase.Append {
+				queue.Enqueue(element)
+			}
+			lenBefore := queue.Len()
+
+			actual := queue.DequeueN(testCase.N)
+
+			assert.Equal(t, testCase.Expected, actual)
+			assert.Equal(t, lenBefore-len(testCase.Expected), queue.Len())
+		})
+	}
+
+	t.Run("negative", func(t *testing.T) {
+		require.Panics(t, func() {
+			NewQueue[int]().DequeueN(-1)
+		})
+	})
+}
+
+func TestDrainTo(t *testing.T) {
+	queue := NewQueue[int]()
+	queue.Grow(4)
+	queue.EnqueueSlice([]int{1, 2, 3, 4})
+	queue.DequeueN(2)
+	queue.EnqueueSlice([]int{5, 6})
+
+	dst := make([]int, 3)
+	first := queue.DrainTo(dst)
+	firstContents := slices.Clone(dst[:first])
+	second := queue.DrainTo(dst)
+
+	assert.Equal(t, 3, first)
+	assert.Equal(t, []int{3, 4, 5}, firstContents)
+	assert.Equal(t, 1, second)
+	assert.Equal(t, []int{6}, dst[:second])
+	assert.Equal(t, 0, queue.DrainTo(dst))
+	assert.Equal(t, []int{}, queue.ToSlice())
+}
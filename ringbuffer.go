@@ -0,0 +1,132 @@
+package collections
+
+import (
+	"fmt"
+	"iter"
+)
+
+type RingBufferMode int
+
+const (
+	// RingBufferOverwrite evicts the oldest element to make room for a new one.
+	RingBufferOverwrite RingBufferMode = iota
+	// RingBufferReject refuses new elements while the buffer is full.
+	RingBufferReject
+)
+
+// RingBuffer is a fixed-capacity queue built on the Queue ring buffer.
+type RingBuffer[T any] struct {
+	queue   Queue[T]
+	mode    RingBufferMode
+	evicted int
+	onEvict func(T)
+}
+
+func NewRingBuffer[T any](capacity int, mode RingBufferMode) *RingBuffer[T] {
+	if capacity <= 0 {
+		panic("ring buffer capacity must be positive")
+	}
+
+	if mode != RingBufferOverwrite && mode != RingBufferReject {
+		panic("unknown ring buffer mode")
+	}
+
+	ringBuffer := RingBuffer[T]{
+		mode: mode,
+	}
+	ringBuffer.queue.applyOptions(QueueOptions{
+		InitialCapacity: capacity,
+		MaxCapacity:     capacity,
+	})
+
+	return &ringBuffer
+}
+
+// OnEvict registers a callback invoked with every element
+// overwritten in RingBufferOverwrite mode. Nil removes the callback.
+func (rb *RingBuffer[T]) OnEvict(callback func(T)) {
+	rb.onEvict = callback
+}
+
+func (rb *RingBuffer[T]) Enqueue(value T) {
+	if !rb.TryEnqueue(value) {
+		panic(fmt.Errorf("trying to enqueue to full ring buffer: %w", ErrFull))
+	}
+}
+
+// TryEnqueue reports false only in RingBufferReject mode when the buffer is full.
+func (rb *RingBuffer[T]) TryEnqueue(value T) bool {
+	if rb.Full() {
+		if rb.mode == RingBufferReject {
+			return false
+		}
+
+		evicted, _ := rb.queue.TryDequeue()
+		rb.evicted++
+
+		if rb.onEvict != nil {
+			rb.onEvict(evicted)
+		}
+	}
+
+	return rb.queue.TryEnqueue(value)
+}
+
+func (rb *RingBuffer[T]) Dequeue() T {
+	value, ok := rb.TryDequeue()
+	if !ok {
+		panic(fmt.Errorf("trying to dequeue from empty ring buffer: %w", ErrEmpty))
+	}
+
+	return value
+}
+
+func (rb *RingBuffer[T]) TryDequeue() (T, bool) {
+	return rb.queue.TryDequeue()
+}
+
+func (rb *RingBuffer[T]) Peek() T {
+	value, ok := rb.TryPeek()
+	if !ok {
+		panic(fmt.Errorf("trying to peek from empty ring buffer: %w", ErrEmpty))
+	}
+
+	return value
+}
+
+func (rb *RingBuffer[T]) TryPeek() (T, bool) {
+	return rb.queue.TryPeek()
+}
+
+func (rb *RingBuffer[T]) Len() int {
+	return rb.queue.Len()
+}
+
+func (rb *RingBuffer[T]) Cap() int {
+	return rb.queue.Cap()
+}
+
+func (rb *RingBuffer[T]) Full() bool {
+	return rb.queue.Len() == rb.queue.Cap()
+}
+
+// Evicted returns the number of elements overwritten since the buffer was created.
+func (rb *RingBuffer[T]) Evicted() int {
+	return rb.evicted
+}
+
+// All yields the elements from oldest to newest, with the same
+// mutation guarantees as Queue.All.
+func (rb *RingBuffer[T]) All() iter.Seq[T] {
+	return rb.queue.All()
+}
+
+// Indexed is like All but also yields the position of each element.
+func (rb *RingBuffer[T]) Indexed() iter.Seq2[int, T] {
+	return rb.queue.Indexed()
+}
+
+// Drain dequeues and yields elements from oldest to newest until the buffer is empty.
+func (rb *RingBuffer[T]) Drain() iter.Seq[T] {
+	return rb.queue.Drain()
+}
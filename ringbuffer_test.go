@@ -0,0 +1,112 @@
+package collections
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRingBufferOverwrite(t *testing.T) {
+	type TestCase struct {
+		Name            string
+		Capacity        int
+		Input           []int
+		ExpectedContent []int
+		ExpectedEvicted []int
+	}
+
+	testCases := []TestCase{
+		{
+			Name:            "not full",
+			Capacity:        3,
+			Input:           []int{1, 2},
+			ExpectedContent: []int{1, 2},
+			ExpectedEvicted: []int{},
+		},
+		{
+			Name:            "exactly full",
+			Capacity:        3,
+			Input:           []int{1, 2, 3},
+			ExpectedContent: []int{1, 2, 3},
+			ExpectedEvicted: []int{},
+		},
+		{
+			Name:            "overwritten",
+			Capacity:        3,
+			Input:           []int{1, 2, 3, 4, 5},
+			ExpectedContent: []int{3, 4, 5},
+			ExpectedEvicted: []int{1, 2},
+		},
+		{
+			Name:            "single slot",
+			Capacity:        1,
+			Input:           []int{1, 2, 3},
+			ExpectedContent: []int{3},
+			ExpectedEvicted: []int{1, 2},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			ringBuffer := NewRingBuffer[int](testCase.Capacity, RingBufferOverwrite)
+			evicted := make([]int, 0)
+			ringBuffer.OnEvict(func(value int) {
+				evicted = append(evicted, value)
+			})
+
+			for _, element := range testCase.Input {
+				require.True(t, ringBuffer.TryEnqueue(element))
+			}
+
+			assert.Equal(t, testCase.ExpectedContent, slices.Collect(ringBuffer.All()))
+			assert.Equal(t, testCase.ExpectedEvicted, evicted)
+			assert.Equal(t, len(testCase.ExpectedEvicted), ringBuffer.Evicted())
+			assert.Equal(t, testCase.Capacity, ringBuffer.Cap())
+			assert.Equal(t, len(testCase.Input) >= testCase.Capacity, ringBuffer.Full())
+		})
+	}
+}
+
+func TestRingBufferReject(t *testing.T) {
+	ringBuffer := NewRingBuffer[int](2, RingBufferReject)
+
+	require.True(t, ringBuffer.TryEnqueue(1))
+	require.True(t, ringBuffer.TryEnqueue(2))
+	ok := ringBuffer.TryEnqueue(3)
+
+	assert.False(t, ok)
+	assert.True(t, ringBuffer.Full())
+	assert.Equal(t, 0, ringBuffer.Evicted())
+	assert.Equal(t, 1, ringBuffer.Peek())
+
+	func() {
+		defer func() {
+			err, ok := recover().(error)
+			require.True(t, ok)
+			assert.True(t, errors.Is(err, ErrFull))
+		}()
+
+		ringBuffer.Enqueue(3)
+	}()
+
+	assert.Equal(t, 1, ringBuffer.Dequeue())
+	assert.False(t, ringBuffer.Full())
+	assert.True(t, ringBuffer.TryEnqueue(3))
+	assert.Equal(t, []int{2, 3}, slices.Collect(ringBuffer.Drain()))
+
+	_, ok = ringBuffer.TryDequeue()
+	assert.False(t, ok)
+}
+
+func TestRingBufferInvalid(t *testing.T) {
+	require.Panics(t, func() {
+		NewRingBuffer[int](0, RingBufferOverwrite)
+	})
+
+	require.Panics(t, func() {
+		NewRingBuffer[int](1, RingBufferMode(5))
+	})
+}
@@ -0,0 +1,168 @@
+package collections
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+// SPSCQueue is a wait-free bounded queue for exactly one producer goroutine
+// and one consumer goroutine. Enqueue methods may only be called by the producer
+// and Dequeue methods only by the consumer.
+type SPSCQueue[T any] struct {
+	_ cacheLinePad
+	// head is the next position to read, written only by the consumer
+	head atomic.Uint64
+	// tailCache is the consumer's last observed tail
+	tailCache uint64
+	_         cacheLinePad
+	// tail is the next position to write, written only by the producer
+	tail atomic.Uint64
+	// headCache is the producer's last observed head
+	headCache uint64
+	_         cacheLinePad
+	buf       []T
+	mask      uint64
+}
+
+// NewSPSCQueue creates a queue holding at least capacity elements,
+// rounded up to a power of two.
+func NewSPSCQueue[T any](capacity int) *SPSCQueue[T] {
+	if capacity <= 0 {
+		panic("spsc queue capacity must be positive")
+	}
+
+	capacity = nextPowerOfTwo(capacity)
+
+	return &SPSCQueue[T]{
+		buf:  make([]T, capacity),
+		mask: uint64(capacity - 1),
+	}
+}
+
+func (q *SPSCQueue[T]) Enqueue(value T) {
+	if !q.TryEnqueue(value) {
+		panic(fmt.Errorf("trying to enqueue to full queue: %w", ErrFull))
+	}
+}
+
+func (q *SPSCQueue[T]) TryEnqueue(value T) bool {
+	tail := q.tail.Load()
+
+	if tail-q.headCache == uint64(len(q.buf)) {
+		q.headCache = q.head.Load()
+		if tail-q.headCache == uint64(len(q.buf)) {
+			return false
+		}
+	}
+
+	q.buf[tail&q.mask] = value
+	q.tail.Store(tail + 1)
+
+	return true
+}
+
+// EnqueueN enqueues as many leading elements of values as fit
+// and returns their number.
+func (q *SPSCQueue[T]) EnqueueN(values []T) int {
+	tail := q.tail.Load()
+	free := uint64(len(q.buf)) - (tail - q.headCache)
+
+	if free < uint64(len(values)) {
+		q.headCache = q.head.Load()
+		free = uint64(len(q.buf)) - (tail - q.headCache)
+	}
+
+	n := min(int(free), len(values))
+	if n == 0 {
+		return 0
+	}
+
+	start := int(tail & q.mask)
+	copied := copy(q.buf[start:], values[:n])
+	copy(q.buf, values[copied:n])
+
+	q.tail.Store(tail + uint64(n))
+
+	return n
+}
+
+func (q *SPSCQueue[T]) Dequeue() T {
+	value, ok := q.TryDequeue()
+	if !ok {
+		panic(fmt.Errorf("trying to dequeue from empty queue: %w", ErrEmpty))
+	}
+
+	return value
+}
+
+func (q *SPSCQueue[T]) TryDequeue() (T, bool) {
+	var value T
+
+	head := q.head.Load()
+
+	if head == q.tailCache {
+		q.tailCache = q.tail.Load()
+		if head == q.tailCache {
+			return value, false
+		}
+	}
+
+	var zero T
+
+	value = q.buf[head&q.mask]
+	q.buf[head&q.mask] = zero
+	q.head.Store(head + 1)
+
+	return value, true
+}
+
+// DequeueN fills dst with as many elements as are available
+// and returns their number.
+func (q *SPSCQueue[T]) DequeueN(dst []T) int {
+	head := q.head.Load()
+	available := q.tailCache - head
+
+	if available < uint64(len(dst)) {
+		q.tailCache = q.tail.Load()
+		available = q.tailCache - head
+	}
+
+	n := min(int(available), len(dst))
+	if n == 0 {
+		return 0
+	}
+
+	start := int(head & q.mask)
+	end := start + n
+
+	if end <= len(q.buf) {
+		copy(dst, q.buf[start:end])
+		clear(q.buf[start:end])
+	} else {
+		copied := copy(dst, q.buf[start:])
+		copy(dst[copied:n], q.buf[:n-copied])
+		clear(q.buf[start:])
+		clear(q.buf[:n-copied])
+	}
+
+	q.head.Store(head + uint64(n))
+
+	return n
+}
+
+// Len returns the number of elements in the queue.
+// Under concurrent use it is only a snapshot and may be stale immediately.
+func (q *SPSCQueue[T]) Len() int {
+	head := q.head.Load()
+	tail := q.tail.Load()
+
+	if tail <= head {
+		return 0
+	}
+
+	return int(tail - head)
+}
+
+func (q *SPSCQueue[T]) Cap() int {
+	return len(q.buf)
+}
@@ -0,0 +1,229 @@
+package collections
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSPSCQueueSequential(t *testing.T) {
+	queue := NewSPSCQueue[int](3)
+
+	_, ok := queue.TryDequeue()
+	assert.False(t, ok)
+	assert.Equal(t, 4, queue.Cap())
+
+	for lap := 0; lap < 3; lap++ {
+		for i := 0; i < 4; i++ {
+			require.True(t, queue.TryEnqueue(lap*4+i))
+		}
+		require.False(t, queue.TryEnqueue(-1))
+		require.Equal(t, 4, queue.Len())
+
+		for i := 0; i < 4; i++ {
+			require.Equal(t, lap*4+i, queue.Dequeue())
+		}
+		require.Equal(t, 0, queue.Len())
+	}
+
+	require.Panics(t, func() {
+		queue.Dequeue()
+	})
+}
+
+func TestSPSCQueueBatch(t *testing.T) {
+	type TestCase struct {
+		Name             string
+		Prefill          int
+		Input            []int
+		ExpectedEnqueued int
+		DstLen           int
+		ExpectedDequeued []int
+	}
+
+	testCases := []TestCase{
+		{
+			Name:             "empty batch",
+			Prefill:          0,
+			Input:            []int{},
+			ExpectedEnqueued: 0,
+			DstLen:           4,
+			ExpectedDequeued: []int{},
+		},
+		{
+			Name:             "fits",
+			Prefill:          0,
+			Input:            []int{1, 2, 3},
+			ExpectedEnqueued: 3,
+			DstLen:           8,
+			ExpectedDequeued: []int{1, 2, 3},
+		},
+		{
+			Name:             "partially fits",
+			Prefill:          0,
+			Input:            []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+			ExpectedEnqueued: 8,
+			DstLen:           8,
+			ExpectedDequeued: []int{1, 2, 3, 4, 5, 6, 7, 8},
+		},
+		{
+			Name:             "wraps around",
+			Prefill:          6,
+			Input:            []int{1, 2, 3, 4, 5},
+			ExpectedEnqueued: 5,
+			DstLen:           8,
+			ExpectedDequeued: []int{1, 2, 3, 4, 5},
+		},
+		{
+			Name:             "short destination",
+			Prefill:          0,
+			Input:            []int{1, 2, 3},
+			ExpectedEnqueued: 3,
+			DstLen:           2,
+			ExpectedDequeued: []int{1, 2},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			queue := NewSPSCQueue[int](8)
+
+			// move head and tail forward so that the batch starts mid-buffer
+			for i := 0; i < testCase.Prefill; i++ {
+				queue.Enqueue(-1)
+			}
+			for i := 0; i < testCase.Prefill; i++ {
+				queue.Dequeue()
+			}
+
+			enqueued := queue.EnqueueN(testCase.Input)
+
+			dst := make([]int, testCase.DstLen)
+			dequeued := queue.DequeueN(dst)
+
+			assert.Equal(t, testCase.ExpectedEnqueued, enqueued)
+			assert.Equal(t, testCase.ExpectedDequeued, dst[:dequeued])
+			assert.Equal(t, testCase.ExpectedEnqueued-dequeued, queue.Len())
+		})
+	}
+}
+
+func TestSPSCQueueConcurrent(t *testing.T) {
+	const total = 200000
+
+	queue := NewSPSCQueue[int](128)
+
+	go func() {
+		batch := make([]int, 0, 16)
+		for i := 0; i < total; {
+			if i%3 == 0 {
+				for !queue.TryEnqueue(i) {
+					runtime.Gosched()
+				}
+				i++
+				continue
+			}
+
+			batch = batch[:0]
+			for j := i; j < min(i+16, total); j++ {
+				batch = append(batch, j)
+			}
+			for len(batch) > 0 {
+				n := queue.EnqueueN(batch)
+				batch = batch[n:]
+				i += n
+				if n == 0 {
+					runtime.Gosched()
+				}
+			}
+		}
+	}()
+
+	dst := make([]int, 7)
+	for expected := 0; expected < total; {
+		n := queue.DequeueN(dst)
+		if n == 0 {
+			runtime.Gosched()
+			continue
+		}
+
+		for _, value := range dst[:n] {
+			require.Equal(t, expected, value)
+			expected++
+		}
+	}
+
+	assert.Equal(t, 0, queue.Len())
+}
+
+func BenchmarkSPSCQueue(b *testing.B) {
+	queue := NewSPSCQueue[int](1024)
+	done := make(chan struct{})
+
+	go func() {
+		for i := 0; i < b.N; i++ {
+			for {
+				if _, ok := queue.TryDequeue(); ok {
+					break
+				}
+				runtime.Gosched()
+			}
+		}
+		close(done)
+	}()
+
+	for i := 0; i < b.N; i++ {
+		for !queue.TryEnqueue(i) {
+			runtime.Gosched()
+		}
+	}
+	<-done
+}
+
+func BenchmarkSPSCQueueBatch(b *testing.B) {
+	const batchSize = 64
+
+	queue := NewSPSCQueue[int](1024)
+	done := make(chan struct{})
+
+	go func() {
+		dst := make([]int, batchSize)
+		for received := 0; received < b.N; {
+			n := queue.DequeueN(dst)
+			if n == 0 {
+				runtime.Gosched()
+			}
+			received += n
+		}
+		close(done)
+	}()
+
+	batch := make([]int, batchSize)
+	for sent := 0; sent < b.N; {
+		n := queue.EnqueueN(batch[:min(batchSize, b.N-sent)])
+		if n == 0 {
+			runtime.Gosched()
+		}
+		sent += n
+	}
+	<-done
+}
+
+func BenchmarkSPSCChannel(b *testing.B) {
+	channel := make(chan int, 1024)
+	done := make(chan struct{})
+
+	go func() {
+		for i := 0; i < b.N; i++ {
+			<-channel
+		}
+		close(done)
+	}()
+
+	for i := 0; i < b.N; i++ {
+		channel <- i
+	}
+	<-done
+}
@@ -0,0 +1,107 @@
+package collections
+
+import (
+	"fmt"
+	"iter"
+)
+
+type stableEntry[T any] struct {
+	value T
+	seq   uint64
+}
+
+// StableHeap is a heap in which elements that compare equal
+// are popped in the order they were pushed.
+type StableHeap[T any] struct {
+	heap *Heap[stableEntry[T]]
+	seq  uint64
+}
+
+func NewStableHeap[T any](compare func(T, T) int) *StableHeap[T] {
+	stableHeap := StableHeap[T]{
+		heap: NewHeap(func(a, b stableEntry[T]) int {
+			if cmp := compare(a.value, b.value); cmp != 0 {
+				return cmp
+			}
+
+			if a.seq < b.seq {
+				return -1
+			}
+
+			if a.seq > b.seq {
+				return 1
+			}
+
+			return 0
+		}),
+	}
+
+	return &stableHeap
+}
+
+func (h *StableHeap[T]) Push(value T) {
+	h.heap.Push(stableEntry[T]{
+		value: value,
+		seq:   h.seq,
+	})
+	h.seq++
+}
+
+func (h *StableHeap[T]) Pop() T {
+	value, ok := h.TryPop()
+	if !ok {
+		panic(fmt.Errorf("pop from empty heap: %w", ErrEmpty))
+	}
+
+	return value
+}
+
+func (h *StableHeap[T]) TryPop() (T, bool) {
+	entry, ok := h.heap.TryPop()
+
+	return entry.value, ok
+}
+
+func (h *StableHeap[T]) Peek() T {
+	value, ok := h.TryPeek()
+	if !ok {
+		panic(fmt.Errorf("peek from empty heap: %w", ErrEmpty))
+	}
+
+	return value
+}
+
+func (h *StableHeap[T]) TryPeek() (T, bool) {
+	entry, ok := h.heap.TryPeek()
+
+	return entry.value, ok
+}
+
+func (h *StableHeap[T]) Len() int {
+	return h.heap.Len()
+}
+
+// All yields the elements in unspecified order, with the same
+// mutation guarantees as Heap.All.
+func (h *StableHeap[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for entry := range h.heap.All() {
+			if !yield(entry.value) {
+				return
+			}
+		}
+	}
+}
+
+// Drain pops and yields elements in priority order until the heap is empty,
+// yielding equal elements in the order they were pushed.
+func (h *StableHeap[T]) Drain() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for {
+			value, ok := h.TryPop()
+			if !ok || !yield(value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package collections
+
+import (
+	"errors"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStableHeap(t *testing.T) {
+	type job struct {
+		priority int
+		id       int
+	}
+
+	byPriority := func(a, b job) int {
+		return intCompare(a.priority, b.priority)
+	}
+
+	jobs := make([]job, 10000)
+	for i := range jobs {
+		jobs[i] = job{priority: rand.Intn(10), id: i}
+	}
+
+	expected := slices.Clone(jobs)
+	slices.SortStableFunc(expected, byPriority)
+
+	heap := NewStableHeap(byPriority)
+	for _, j := range jobs {
+		heap.Push(j)
+	}
+
+	assert.Equal(t, len(jobs), heap.Len())
+	assert.Equal(t, expected[0], heap.Peek())
+	all := slices.Collect(heap.All())
+	slices.SortFunc(all, func(a, b job) int {
+		return intCompare(a.id, b.id)
+	})
+	assert.Equal(t, jobs, all)
+	assert.Equal(t, expected, slices.Collect(heap.Drain()))
+}
+
+func TestStableHeapInterleaved(t *testing.T) {
+	heap := NewStableHeap(func(a, b string) int {
+		return intCompare(len(a), len(b))
+	})
+
+	heap.Push("bb")
+	heap.Push("aa")
+	heap.Push("x")
+	assert.Equal(t, "x", heap.Pop())
+
+	heap.Push("cc")
+	heap.Push("y")
+	heap.Push("dd")
+
+	assert.Equal(t, []string{"y", "bb", "aa", "cc", "dd"}, slices.Collect(heap.Drain()))
+}
+
+func TestStableHeapEmpty(t *testing.T) {
+	heap := NewStableHeap(intCompare)
+
+	_, okPop := heap.TryPop()
+	_, okPeek := heap.TryPeek()
+
+	assert.False(t, okPop)
+	assert.False(t, okPeek)
+
+	defer func() {
+		err, ok := recover().(error)
+		require.True(t, ok)
+		assert.True(t, errors.Is(err, ErrEmpty))
+	}()
+
+	heap.Pop()
+}
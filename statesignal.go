@@ -0,0 +1,47 @@
+package collections
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// stateSignal wakes up all goroutines waiting for a change of state guarded by a mutex.
+// Its zero value is ready to use and all methods must be called with the mutex held.
+type stateSignal struct {
+	// ch is created by the first waiter and closed on the next change
+	ch chan struct{}
+}
+
+func (s *stateSignal) broadcast() {
+	if s.ch != nil {
+		close(s.ch)
+		s.ch = nil
+	}
+}
+
+// wait releases mu until the next broadcast or ctx cancellation
+// and reacquires it before returning.
+func (s *stateSignal) wait(ctx context.Context, mu *sync.Mutex) error {
+	return s.waitUntil(ctx, mu, nil)
+}
+
+// waitUntil is like wait but also returns once timer fires. A nil timer never fires.
+func (s *stateSignal) waitUntil(ctx context.Context, mu *sync.Mutex, timer <-chan time.Time) error {
+	if s.ch == nil {
+		s.ch = make(chan struct{})
+	}
+
+	ch := s.ch
+	mu.Unlock()
+	defer mu.Lock()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-ch:
+		return nil
+	case <-timer:
+		return nil
+	}
+}
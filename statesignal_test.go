@@ -0,0 +1,75 @@
+package collections
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// waitParked polls until a goroutine waits on signal, so that tests
+// act on a blocked call without sleeping first. A waiter that gave up
+// keeps the signal armed until the next broadcast.
+func waitParked(t *testing.T, mu *sync.Mutex, signal *stateSignal) {
+	t.Helper()
+
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return signal.ch != nil
+	}, 10*time.Second, time.Millisecond)
+}
+
+func TestStateSignal(t *testing.T) {
+	t.Run("broadcast wakes all waiters", func(t *testing.T) {
+		var mu sync.Mutex
+		var signal stateSignal
+
+		errs := make(chan error, 2)
+		parked := 0
+		for i := 0; i < 2; i++ {
+			go func() {
+				mu.Lock()
+				defer mu.Unlock()
+
+				// the mutex is only released inside wait, so counting under it means parked
+				parked++
+				errs <- signal.wait(context.Background(), &mu)
+			}()
+		}
+
+		require.Eventually(t, func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+
+			return parked == 2
+		}, 10*time.Second, time.Millisecond)
+
+		mu.Lock()
+		signal.broadcast()
+		mu.Unlock()
+
+		assert.NoError(t, <-errs)
+		assert.NoError(t, <-errs)
+	})
+
+	t.Run("timer and context end the wait", func(t *testing.T) {
+		var mu sync.Mutex
+		var signal stateSignal
+
+		timer := make(chan time.Time, 1)
+		timer <- time.Now()
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		assert.NoError(t, signal.waitUntil(context.Background(), &mu, timer))
+		assert.ErrorIs(t, signal.wait(ctx, &mu), context.Canceled)
+	})
+}
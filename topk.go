@@ -0,0 +1,93 @@
+package collections
+
+import (
+	"iter"
+	"slices"
+)
+
+// TopK keeps the k greatest elements offered to it according to compare.
+// Internally it is a heap whose root is the least of the kept elements,
+// so each rejected or evicting Offer costs one comparison and at most O(log k).
+type TopK[T any] struct {
+	heap *Heap[T]
+	k    int
+}
+
+func NewTopK[T any](k int, compare func(T, T) int) *TopK[T] {
+	if k <= 0 {
+		panic("top k size must be positive")
+	}
+
+	heap := NewHeap(compare)
+	heap.slice = make([]T, 0, k)
+
+	topK := TopK[T]{
+		heap: heap,
+		k:    k,
+	}
+
+	return &topK
+}
+
+// Offer adds value if it is among the k greatest seen so far,
+// evicting the least kept element when full, and reports whether value was kept.
+// A value equal to the least kept element of a full TopK is not kept.
+func (t *TopK[T]) Offer(value T) bool {
+	if len(t.heap.slice) < t.k {
+		t.heap.Push(value)
+		return true
+	}
+
+	if t.heap.compare(value, t.heap.slice[0]) <= 0 {
+		return false
+	}
+
+	t.heap.Replace(value)
+
+	return true
+}
+
+// Sorted returns the kept elements from greatest to least.
+func (t *TopK[T]) Sorted() []T {
+	sorted := slices.Clone(t.heap.slice)
+	slices.SortFunc(sorted, func(a, b T) int {
+		return t.heap.compare(b, a)
+	})
+
+	return sorted
+}
+
+func (t *TopK[T]) Reset() {
+	clear(t.heap.slice)
+	t.heap.slice = t.heap.slice[:0]
+}
+
+func (t *TopK[T]) Len() int {
+	return t.heap.Len()
+}
+
+func (t *TopK[T]) K() int {
+	return t.k
+}
+
+// All yields the kept elements in unspecified order.
+func (t *TopK[T]) All() iter.Seq[T] {
+	return t.heap.All()
+}
+
+// SmallestK returns the k smallest elements of values in ascending order.
+func SmallestK[T any](values iter.Seq[T], k int, compare func(T, T) int) []T {
+	return LargestK(values, k, func(a, b T) int {
+		return compare(b, a)
+	})
+}
+
+// LargestK returns the k largest elements of values in descending order.
+func LargestK[T any](values iter.Seq[T], k int, compare func(T, T) int) []T {
+	topK := NewTopK(k, compare)
+	for value := range values {
+		topK.Offer(value)
+	}
+
+	return topK.Sorted()
+}
@@ -0,0 +1,100 @@
+package collections
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTopK(t *testing.T) {
+	type TestCase struct {
+		Name     string
+		K        int
+		Input    []int
+		Expected []int
+	}
+
+	testCases := []TestCase{
+		{
+			Name:     "no input",
+			K:        3,
+			Input:    []int{},
+			Expected: []int{},
+		},
+		{
+			Name:     "fewer than k",
+			K:        3,
+			Input:    []int{2, 7},
+			Expected: []int{7, 2},
+		},
+		{
+			Name:     "more than k",
+			K:        3,
+			Input:    []int{5, 1, 9, 3, 7, 2, 8},
+			Expected: []int{9, 8, 7},
+		},
+		{
+			Name:     "duplicates",
+			K:        2,
+			Input:    []int{4, 4, 4, 1},
+			Expected: []int{4, 4},
+		},
+		{
+			Name:     "single slot",
+			K:        1,
+			Input:    []int{3, 9, 1},
+			Expected: []int{9},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			topK := NewTopK(testCase.K, intCompare)
+			for _, value := range testCase.Input {
+				topK.Offer(value)
+			}
+
+			assert.Equal(t, testCase.Expected, topK.Sorted())
+			assert.Equal(t, len(testCase.Expected), topK.Len())
+			assert.ElementsMatch(t, testCase.Expected, slices.Collect(topK.All()))
+		})
+	}
+}
+
+func TestTopKOffer(t *testing.T) {
+	topK := NewTopK(2, intCompare)
+
+	assert.True(t, topK.Offer(5))
+	assert.True(t, topK.Offer(3))
+	assert.False(t, topK.Offer(1))
+	assert.False(t, topK.Offer(3))
+	assert.True(t, topK.Offer(4))
+	assert.Equal(t, []int{5, 4}, topK.Sorted())
+
+	topK.Reset()
+
+	assert.Equal(t, 0, topK.Len())
+	assert.Equal(t, 2, topK.K())
+	assert.True(t, topK.Offer(1))
+	assert.Equal(t, []int{1}, topK.Sorted())
+
+	require.Panics(t, func() {
+		NewTopK(0, intCompare)
+	})
+}
+
+func TestSmallestLargestK(t *testing.T) {
+	input := make([]int, 10000)
+	for i := range input {
+		input[i] = rand.Intn(100000)
+	}
+	sorted := slices.Sorted(slices.Values(input))
+	descending := slices.Clone(sorted)
+	slices.Reverse(descending)
+
+	assert.Equal(t, sorted[:100], SmallestK(slices.Values(input), 100, intCompare))
+	assert.Equal(t, descending[:100], LargestK(slices.Values(input), 100, intCompare))
+}